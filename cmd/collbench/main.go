@@ -0,0 +1,82 @@
+// Command collbench runs the benchmarks of the collections and prints them as a comparison table.
+//
+// Every benchmark gets its own table, whose rows are its cases, e.g. dist=random/size=1000 for BenchmarkMapGet,
+// and whose columns are the implementations compared by it, taken from the impl= element of the sub-benchmark
+// names. The best value of every row is marked with a star and the others are followed by how many times worse
+// they are:
+//
+//	go run ./cmd/collbench -bench 'BenchmarkMapGet$/dist=random/'
+//	go run ./cmd/collbench -metric allocs/op -bench 'Build/.*/size=10000$/'
+//
+// The output of a previous run of go test -bench can be tabulated instead with -input.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+)
+
+const benchPackage = "utils-generics/collections/benchmarks"
+
+func main() {
+	bench := flag.String("bench", ".", "regular expression selecting the benchmarks to run, as for go test -bench")
+	benchtime := flag.String("benchtime", "200ms", "run time of each benchmark, as for go test -benchtime")
+	metric := flag.String("metric", "ns/op", "metric to compare: ns/op, B/op, allocs/op or any other one reported")
+	input := flag.String("input", "", "file holding the output of go test -bench to tabulate instead of running it, - for stdin")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: collbench [-bench regexp] [-benchtime d] [-metric unit] [-input file]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*bench, *benchtime, *metric, *input, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "collbench: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(bench, benchtime, metric, input string, w io.Writer) error {
+	var r io.Reader
+	switch input {
+	case "":
+		out, err := runBenchmarks(bench, benchtime)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(out)
+	case "-":
+		r = os.Stdin
+	default:
+		f, err := os.Open(input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	results, err := parse(r, metric)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("no benchmark reports %s", metric)
+	}
+	return writeTables(w, makeTables(results), metric)
+}
+
+// runBenchmarks runs the selected benchmarks and returns the output of go test
+func runBenchmarks(bench, benchtime string) ([]byte, error) {
+	cmd := exec.Command("go", "test", "-run", "^$", "-bench", bench, "-benchmem", "-benchtime", benchtime, benchPackage)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		os.Stderr.Write(out)
+		return nil, fmt.Errorf("go test: %v", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// result is the value of a metric for an implementation in a case of a benchmark
+type result struct {
+	bench string
+	row   string
+	impl  string
+	value float64
+}
+
+// parse reads the benchmark lines of the output of go test -bench and returns the values of the metric.
+// Benchmarks without an impl= element in their name compare nothing and are ignored.
+func parse(r io.Reader, metric string) ([]result, error) {
+	var results []result
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+
+		bench, row, impl, ok := splitName(fields[0])
+		if !ok {
+			continue
+		}
+		// the iteration count is followed by value and unit pairs
+		for i := 2; i+1 < len(fields); i += 2 {
+			if fields[i+1] != metric {
+				continue
+			}
+			value, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid %s value %q", fields[0], metric, fields[i])
+			}
+			results = append(results, result{bench: bench, row: row, impl: impl, value: value})
+		}
+	}
+	return results, scanner.Err()
+}
+
+// splitName splits the name of a sub-benchmark, without its GOMAXPROCS suffix, into the name of the top-level
+// benchmark, the case it runs and the implementation it measures
+func splitName(name string) (string, string, string, bool) {
+	if i := strings.LastIndexByte(name, '-'); i >= 0 {
+		if _, err := strconv.Atoi(name[i+1:]); err == nil {
+			name = name[:i]
+		}
+	}
+
+	elements := strings.Split(name, "/")
+	for i, element := range elements {
+		if impl, ok := strings.CutPrefix(element, "impl="); ok && i > 0 {
+			row := append(elements[1:i:i], elements[i+1:]...)
+			return elements[0], strings.Join(row, "/"), impl, true
+		}
+	}
+	return "", "", "", false
+}
+
+// table is the results of a benchmark pivoted by implementation, the rows and columns being in order
+// of first appearance
+type table struct {
+	bench  string
+	rows   []string
+	impls  []string
+	values map[string]map[string]float64
+}
+
+// makeTables pivots the results into a table per benchmark, averaging the values of the benchmarks run
+// several times with -count
+func makeTables(results []result) []*table {
+	var tables []*table
+	byBench := map[string][]result{}
+	for _, r := range results {
+		if byBench[r.bench] == nil {
+			tables = append(tables, &table{bench: r.bench})
+		}
+		byBench[r.bench] = append(byBench[r.bench], r)
+	}
+	for _, t := range tables {
+		t.fill(byBench[t.bench])
+	}
+	return tables
+}
+
+func (t *table) fill(results []result) {
+	t.values = map[string]map[string]float64{}
+	counts := map[string]map[string]int{}
+	seenImpls := map[string]bool{}
+	for _, r := range results {
+		if t.values[r.row] == nil {
+			t.rows = append(t.rows, r.row)
+			t.values[r.row] = map[string]float64{}
+			counts[r.row] = map[string]int{}
+		}
+		if !seenImpls[r.impl] {
+			seenImpls[r.impl] = true
+			t.impls = append(t.impls, r.impl)
+		}
+		counts[r.row][r.impl]++
+		n := float64(counts[r.row][r.impl])
+		t.values[r.row][r.impl] += (r.value - t.values[r.row][r.impl]) / n
+	}
+}
+
+// best returns the lowest value of the row
+func (t *table) best(row string) float64 {
+	first := true
+	var best float64
+	for _, value := range t.values[row] {
+		if first || value < best {
+			best, first = value, false
+		}
+	}
+	return best
+}
+
+// writeTables writes the tables one after the other, separated by an empty line
+func writeTables(w io.Writer, tables []*table, metric string) error {
+	for i, t := range tables {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		if err := writeTable(w, t, metric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTable writes the table aligned in columns, marking the best value of each row with a star and following
+// the others with their ratio to it
+func writeTable(w io.Writer, t *table, metric string) error {
+	// the values are aligned to the right, the names of the rows are padded to be aligned to the left
+	width := len(t.bench) + len(metric) + 3
+	for _, row := range t.rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "%-*s\t", width, t.bench+" ("+metric+")")
+	for _, impl := range t.impls {
+		fmt.Fprintf(tw, "%s\t\t", impl)
+	}
+	fmt.Fprintln(tw)
+
+	// every implementation has two columns, the value and how it compares to the best one
+	for _, row := range t.rows {
+		fmt.Fprintf(tw, "%-*s\t", width, row)
+		best := t.best(row)
+		for _, impl := range t.impls {
+			value, ok := t.values[row][impl]
+			switch {
+			case !ok:
+				fmt.Fprint(tw, "-\t\t")
+			case value == best:
+				fmt.Fprintf(tw, "%s\t*\t", formatValue(value))
+			case best == 0:
+				fmt.Fprintf(tw, "%s\t\t", formatValue(value))
+			default:
+				fmt.Fprintf(tw, "%s\tx%.1f\t", formatValue(value), value/best)
+			}
+		}
+		fmt.Fprintln(tw)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	// the padding is added to the left of the cells, and the empty cells closing the header leave spaces behind
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n") {
+		if _, err := fmt.Fprintln(w, strings.TrimRight(strings.TrimPrefix(line, "  "), " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatValue formats the value with three significant digits at least and no exponent
+func formatValue(value float64) string {
+	if value >= 100 || value == float64(int64(value)) {
+		return strconv.FormatFloat(value, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(value, 'g', 3, 64)
+}
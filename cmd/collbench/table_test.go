@@ -0,0 +1,79 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSplitName(t *testing.T) {
+	bench, row, impl, ok := splitName("BenchmarkMapGet/dist=random/size=100/impl=HashMap-8")
+	assert.True(t, ok)
+	assert.Equal(t, "BenchmarkMapGet", bench)
+	assert.Equal(t, "dist=random/size=100", row)
+	assert.Equal(t, "HashMap", impl)
+
+	// without the GOMAXPROCS suffix and with the implementation in the middle
+	bench, row, impl, ok = splitName("BenchmarkVector/impl=slice/op=Get")
+	assert.True(t, ok)
+	assert.Equal(t, "BenchmarkVector", bench)
+	assert.Equal(t, "op=Get", row)
+	assert.Equal(t, "slice", impl)
+
+	_, _, _, ok = splitName("BenchmarkNoImpl-8")
+	assert.False(t, ok)
+}
+
+func TestParse(t *testing.T) {
+	input := "BenchmarkMapGet/size=100/impl=map-8 \t 1000 \t 10.5 ns/op \t 16 B/op \t 1 allocs/op\n" +
+		"--- SKIP: BenchmarkMapGet/size=1000/impl=map\n" +
+		"BenchmarkNoImpl-8 \t 1000 \t 1.00 ns/op\n"
+
+	results, err := parse(strings.NewReader(input), "ns/op")
+	assert.NoError(t, err)
+	assert.Equal(t, []result{{bench: "BenchmarkMapGet", row: "size=100", impl: "map", value: 10.5}}, results)
+
+	results, err = parse(strings.NewReader(input), "B/op")
+	assert.NoError(t, err)
+	assert.Equal(t, []result{{bench: "BenchmarkMapGet", row: "size=100", impl: "map", value: 16}}, results)
+
+	_, err = parse(strings.NewReader("BenchmarkMapGet/impl=map-8 \t 1000 \t fast ns/op\n"), "ns/op")
+	assert.EqualError(t, err, `BenchmarkMapGet/impl=map-8: invalid ns/op value "fast"`)
+}
+
+func TestMakeTables_AveragesRepeatedRuns(t *testing.T) {
+	tables := makeTables([]result{
+		{bench: "BenchmarkA", row: "size=1", impl: "x", value: 10},
+		{bench: "BenchmarkB", row: "size=1", impl: "y", value: 5},
+		{bench: "BenchmarkA", row: "size=1", impl: "x", value: 20},
+		{bench: "BenchmarkA", row: "size=2", impl: "z", value: 30},
+	})
+
+	assert.Len(t, tables, 2)
+	assert.Equal(t, "BenchmarkA", tables[0].bench)
+	assert.Equal(t, []string{"size=1", "size=2"}, tables[0].rows)
+	assert.Equal(t, []string{"x", "z"}, tables[0].impls)
+	assert.Equal(t, 15.0, tables[0].values["size=1"]["x"])
+	assert.Equal(t, "BenchmarkB", tables[1].bench)
+}
+
+func TestRun_Input(t *testing.T) {
+	var out strings.Builder
+	err := run("", "", "ns/op", filepath.Join("testdata", "bench.txt"), &out)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ""+
+		"BenchmarkMapGet (ns/op)  HashMap        map\n"+
+		"dist=random/size=100          40  x4.0   10     *\n"+
+		"dist=random/size=1000         50     *  100  x2.0\n"+
+		"\n"+
+		"BenchmarkQueue (ns/op)  SimpleQueue        slice\n"+
+		"size=100                          5  x2.0    2.5  *\n", out.String())
+}
+
+func TestRun_MissingMetric(t *testing.T) {
+	err := run("", "", "MB/s", filepath.Join("testdata", "bench.txt"), &strings.Builder{})
+
+	assert.EqualError(t, err, "no benchmark reports MB/s")
+}
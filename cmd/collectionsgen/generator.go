@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultOutput = "collections_gen.go"
+	annotation    = "//collections:generate"
+	typesImport   = "utils-generics/collections/types"
+)
+
+// basicField describes how a field of a basic type is hashed and compared with the types package.
+// Hashers only exist for the most common types, the other ones are converted to the type of the hasher.
+type basicField struct {
+	hasher     string
+	hashAs     string
+	comparator string
+}
+
+var basicFields = map[string]basicField{
+	"string":     {hasher: "StringHash", comparator: "StringComparator"},
+	"bool":       {hasher: "BoolHash", comparator: "BoolComparator"},
+	"int":        {hasher: "IntHash", comparator: "IntComparator"},
+	"int8":       {hasher: "IntHash", hashAs: "int", comparator: "Int8Comparator"},
+	"int16":      {hasher: "IntHash", hashAs: "int", comparator: "Int16Comparator"},
+	"int32":      {hasher: "RuneHash", comparator: "Int32Comparator"},
+	"rune":       {hasher: "RuneHash", comparator: "RuneComparator"},
+	"int64":      {hasher: "IntHash", hashAs: "int", comparator: "Int64Comparator"},
+	"uint":       {hasher: "IntHash", hashAs: "int", comparator: "UintComparator"},
+	"uint8":      {hasher: "ByteHash", comparator: "Uint8Comparator"},
+	"byte":       {hasher: "ByteHash", comparator: "ByteComparator"},
+	"uint16":     {hasher: "IntHash", hashAs: "int", comparator: "Uint16Comparator"},
+	"uint32":     {hasher: "IntHash", hashAs: "int", comparator: "Uint32Comparator"},
+	"uint64":     {hasher: "IntHash", hashAs: "int", comparator: "Uint64Comparator"},
+	"uintptr":    {hasher: "IntHash", hashAs: "int", comparator: "UintptrComparator"},
+	"float32":    {hasher: "Float32Hash", comparator: "Float32Comparator"},
+	"float64":    {hasher: "Float64Hash", comparator: "Float64Comparator"},
+	"complex64":  {hasher: "Complex64Hash", comparator: "Complex64Comparator"},
+	"complex128": {hasher: "Complex128Hash", comparator: "Complex128Comparator"},
+}
+
+// field is a struct field along with the expressions hashing and comparing it
+type field struct {
+	name string
+	// basic is the basic type the field is converted to, empty for annotated struct fields
+	basic string
+	// named is true if the field type is a named type of the package and has to be converted to basic
+	named bool
+	// structType is the annotated struct type of the field, if any
+	structType string
+}
+
+type structType struct {
+	name   string
+	fields []field
+}
+
+// run generates the hashers and comparators for the package in dir, writing them to output in the same directory
+func run(dir, output string) error {
+	pkgName, files, err := parseDir(dir, output)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(pkgName, files)
+	if err != nil {
+		return err
+	}
+	if src == nil {
+		return fmt.Errorf("no struct annotated with %s in %s", annotation, dir)
+	}
+	return os.WriteFile(filepath.Join(dir, output), src, 0o644)
+}
+
+// parseDir parses the non test files of the package in dir, skipping a previously generated output
+func parseDir(dir, output string) (string, []*ast.File, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != output
+	}, parser.ParseComments)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(pkgs) != 1 {
+		return "", nil, fmt.Errorf("expected exactly one package in %s, found %d", dir, len(pkgs))
+	}
+
+	for name, pkg := range pkgs {
+		fileNames := make([]string, 0, len(pkg.Files))
+		for fileName := range pkg.Files {
+			fileNames = append(fileNames, fileName)
+		}
+		sort.Strings(fileNames)
+
+		files := make([]*ast.File, 0, len(fileNames))
+		for _, fileName := range fileNames {
+			files = append(files, pkg.Files[fileName])
+		}
+		return name, files, nil
+	}
+	panic("unreachable")
+}
+
+// generate returns the formatted source of the hashers and comparators of the annotated structs,
+// or nil if there are none
+func generate(pkgName string, files []*ast.File) ([]byte, error) {
+	specs, annotated := collectTypes(files)
+
+	var structs []structType
+	for _, spec := range specs {
+		if !annotated[spec.Name.Name] {
+			continue
+		}
+		st, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return nil, fmt.Errorf("%s is annotated with %s but is not a struct", spec.Name.Name, annotation)
+		}
+		if spec.TypeParams != nil {
+			return nil, fmt.Errorf("%s is annotated with %s but is generic", spec.Name.Name, annotation)
+		}
+
+		fields, err := resolveFields(spec.Name.Name, st, specs, annotated)
+		if err != nil {
+			return nil, err
+		}
+		structs = append(structs, structType{name: spec.Name.Name, fields: fields})
+	}
+	if len(structs) == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by collectionsgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	writeImports(&buf, structs)
+	for _, s := range structs {
+		writeHasher(&buf, s)
+		writeComparator(&buf, s)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// writeImports imports math if any hash combines fields, and the types package if any field is hashed
+// and compared with its functions rather than with the ones generated for another struct
+func writeImports(buf *bytes.Buffer, structs []structType) {
+	usesMath, usesTypes := false, false
+	for _, s := range structs {
+		usesMath = usesMath || len(s.fields) > 0
+		for _, f := range s.fields {
+			usesTypes = usesTypes || f.structType == ""
+		}
+	}
+
+	var imports []string
+	if usesMath {
+		imports = append(imports, "math")
+	}
+	if usesTypes {
+		imports = append(imports, typesImport)
+	}
+	switch len(imports) {
+	case 0:
+	case 1:
+		fmt.Fprintf(buf, "import %q\n", imports[0])
+	default:
+		fmt.Fprintf(buf, "import (\n")
+		for _, path := range imports {
+			fmt.Fprintf(buf, "%q\n", path)
+		}
+		fmt.Fprintf(buf, ")\n")
+	}
+}
+
+// collectTypes returns every type declared in the files, in order, and which of them carry the annotation
+func collectTypes(files []*ast.File) ([]*ast.TypeSpec, map[string]bool) {
+	var specs []*ast.TypeSpec
+	annotated := map[string]bool{}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				specs = append(specs, ts)
+				// the annotation of a lone type ends up on the declaration, the one of a grouped type on the spec
+				if hasAnnotation(ts.Doc) || (len(gen.Specs) == 1 && hasAnnotation(gen.Doc)) {
+					annotated[ts.Name.Name] = true
+				}
+			}
+		}
+	}
+	return specs, annotated
+}
+
+func hasAnnotation(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if strings.TrimSpace(c.Text) == annotation {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveFields(name string, st *ast.StructType, specs []*ast.TypeSpec, annotated map[string]bool) ([]field, error) {
+	var fields []field
+	for _, f := range st.Fields.List {
+		if f.Tag != nil && reflect.StructTag(strings.Trim(f.Tag.Value, "`")).Get("collections") == "-" {
+			continue
+		}
+
+		names := make([]string, 0, len(f.Names))
+		for _, n := range f.Names {
+			if n.Name != "_" {
+				names = append(names, n.Name)
+			}
+		}
+
+		ident, ok := f.Type.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("%s: field type %s is not supported, tag the field with collections:\"-\" to skip it",
+				name, exprString(f.Type))
+		}
+		if len(f.Names) == 0 {
+			// embedded field
+			names = append(names, ident.Name)
+		}
+
+		resolved, err := resolveType(ident.Name, specs, annotated, map[string]bool{})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		for _, n := range names {
+			resolved.name = n
+			fields = append(fields, resolved)
+		}
+	}
+	return fields, nil
+}
+
+// resolveType finds how to hash and compare a type, following named types of the package down to a basic type
+func resolveType(name string, specs []*ast.TypeSpec, annotated map[string]bool, seen map[string]bool) (field, error) {
+	if annotated[name] {
+		return field{structType: name}, nil
+	}
+
+	for _, spec := range specs {
+		if spec.Name.Name != name {
+			continue
+		}
+		ident, ok := spec.Type.(*ast.Ident)
+		if !ok || seen[name] {
+			break
+		}
+		seen[name] = true
+		resolved, err := resolveType(ident.Name, specs, annotated, seen)
+		if err != nil || resolved.structType != "" {
+			break
+		}
+		resolved.named = true
+		return resolved, nil
+	}
+
+	if _, ok := basicFields[name]; ok {
+		return field{basic: name}, nil
+	}
+	return field{}, fmt.Errorf("field type %s is not supported, tag the field with collections:\"-\" to skip it", name)
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+func writeHasher(buf *bytes.Buffer, s structType) {
+	fmt.Fprintf(buf, "\n// Hash%s hashes %s values field by field, for hash based collections such as dict.MakeHashMap\n", s.name, s.name)
+	fmt.Fprintf(buf, "func Hash%s(v %s) int {\n", s.name, s.name)
+	if len(s.fields) == 0 {
+		fmt.Fprintf(buf, "return 0\n}\n")
+		return
+	}
+
+	fmt.Fprintf(buf, "h := uint64(17)\n")
+	for _, f := range s.fields {
+		fmt.Fprintf(buf, "h = 31*h + uint64(%s)\n", hashExpr(f, "v."+f.name))
+	}
+	// masking keeps the hash non-negative on both 32 and 64-bit platforms, as types.nonNegative does
+	fmt.Fprintf(buf, "return int(h & math.MaxInt)\n}\n")
+}
+
+func hashExpr(f field, operand string) string {
+	if f.structType != "" {
+		return fmt.Sprintf("Hash%s(%s)", f.structType, operand)
+	}
+
+	basic := basicFields[f.basic]
+	switch {
+	case basic.hashAs != "":
+		operand = fmt.Sprintf("%s(%s)", basic.hashAs, operand)
+	case f.named:
+		operand = fmt.Sprintf("%s(%s)", f.basic, operand)
+	}
+	return fmt.Sprintf("types.%s(%s)", basic.hasher, operand)
+}
+
+func writeComparator(buf *bytes.Buffer, s structType) {
+	fmt.Fprintf(buf, "\n// Compare%s orders %s values field by field, in declaration order,\n", s.name, s.name)
+	fmt.Fprintf(buf, "// for sorted collections such as dict.MakeBinaryTreeMap or set.MakeFlatSet\n")
+	fmt.Fprintf(buf, "func Compare%s(a, b %s) int {\n", s.name, s.name)
+	for i, f := range s.fields {
+		expr := compareExpr(f, "a."+f.name, "b."+f.name)
+		if i == len(s.fields)-1 {
+			fmt.Fprintf(buf, "return %s\n}\n", expr)
+			return
+		}
+		fmt.Fprintf(buf, "if c := %s; c != 0 {\nreturn c\n}\n", expr)
+	}
+	fmt.Fprintf(buf, "return 0\n}\n")
+}
+
+func compareExpr(f field, a, b string) string {
+	if f.structType != "" {
+		return fmt.Sprintf("Compare%s(%s, %s)", f.structType, a, b)
+	}
+	if f.named {
+		a = fmt.Sprintf("%s(%s)", f.basic, a)
+		b = fmt.Sprintf("%s(%s)", f.basic, b)
+	}
+	return fmt.Sprintf("types.%s(%s, %s)", basicFields[f.basic].comparator, a, b)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func generateDir(t *testing.T, dir string) ([]byte, error) {
+	pkgName, files, err := parseDir(dir, defaultOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return generate(pkgName, files)
+}
+
+func testGolden(t *testing.T, dir string) {
+	src, err := generateDir(t, dir)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	golden := filepath.Join(dir, defaultOutput+".golden")
+	if *update {
+		if err := os.WriteFile(golden, src, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(expected), string(src))
+}
+
+func TestGenerate_Basic(t *testing.T) {
+	testGolden(t, filepath.Join("testdata", "basic"))
+}
+
+func TestGenerate_Nested(t *testing.T) {
+	testGolden(t, filepath.Join("testdata", "nested"))
+}
+
+func TestGenerate_UnsupportedField(t *testing.T) {
+	_, err := generateDir(t, filepath.Join("testdata", "unsupported"))
+
+	assert.EqualError(t, err, `Bag: field type []string is not supported, tag the field with collections:"-" to skip it`)
+}
+
+func TestRun_WritesOutput(t *testing.T) {
+	dir := t.TempDir()
+	src, err := os.ReadFile(filepath.Join("testdata", "basic", "point.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "point.go"), src, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// running twice must not pick up the output of the first run
+	assert.NoError(t, run(dir, defaultOutput))
+	assert.NoError(t, run(dir, defaultOutput))
+
+	generated, err := os.ReadFile(filepath.Join(dir, defaultOutput))
+	assert.NoError(t, err)
+	expected, err := os.ReadFile(filepath.Join("testdata", "basic", defaultOutput+".golden"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(generated))
+}
+
+func TestRun_NoAnnotation(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "plain.go"), []byte("package plain\n\ntype Plain struct{ X int }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Error(t, run(dir, defaultOutput))
+}
+
+func TestGenerate_NoTypesFields(t *testing.T) {
+	// no field is hashed with the types package, which must not be imported
+	testGolden(t, filepath.Join("testdata", "skipped"))
+}
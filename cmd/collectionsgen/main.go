@@ -0,0 +1,43 @@
+// Command collectionsgen generates hashers and comparators for struct keys.
+//
+// Every struct type annotated with a //collections:generate comment gets a Hash<Type> function,
+// usable with dict.MakeHashMap or set.MakeHashSet, and a Compare<Type> function, usable with
+// dict.MakeBinaryTreeMap, dict.MakeFlatMap, set.MakeFlatSet etc. Both combine the field-level defaults
+// of the types package, visiting the fields in declaration order:
+//
+//	//go:generate go run utils-generics/cmd/collectionsgen
+//
+//	//collections:generate
+//	type Point struct {
+//		X, Y  int
+//		Label string `collections:"-"`
+//	}
+//
+// Fields tagged with collections:"-" are ignored by both functions. Fields must be of a basic type,
+// of a named type of the same package based on one, or of another annotated struct type.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	output := flag.String("output", defaultOutput, "name of the generated file, written to the package directory")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: collectionsgen [-output file] [package directory]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	if err := run(dir, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "collectionsgen: %v\n", err)
+		os.Exit(1)
+	}
+}
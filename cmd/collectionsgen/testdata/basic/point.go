@@ -0,0 +1,12 @@
+package geometry
+
+//collections:generate
+type Point struct {
+	X, Y  int
+	Label string `collections:"-"`
+}
+
+// not annotated, so ignored
+type Segment struct {
+	From, To Point
+}
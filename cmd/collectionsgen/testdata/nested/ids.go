@@ -0,0 +1,7 @@
+package inventory
+
+type ID uint64
+
+type Code ID
+
+type Weight float64
@@ -0,0 +1,27 @@
+package inventory
+
+type (
+	//collections:generate
+	Dimensions struct {
+		Width, Height float32
+		Depth         Weight
+	}
+
+	//collections:generate
+	Item struct {
+		Dimensions
+		ID       ID
+		SKU      Code
+		Name     string
+		Flags    uint8
+		Grade    rune
+		Level    int16
+		Fragile  bool
+		Phase    complex128
+		Tags     []string `collections:"-"`
+		_        int
+	}
+)
+
+//collections:generate
+type Empty struct{}
@@ -0,0 +1,15 @@
+package skipped
+
+//collections:generate
+type Marker struct{}
+
+//collections:generate
+type Cached struct {
+	Data  []byte `collections:"-"`
+	Count int    `collections:"-"`
+}
+
+//collections:generate
+type Pair struct {
+	Left, Right Marker
+}
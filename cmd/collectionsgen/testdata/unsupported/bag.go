@@ -0,0 +1,7 @@
+package bag
+
+//collections:generate
+type Bag struct {
+	Name  string
+	Items []string
+}
@@ -0,0 +1,23 @@
+// Package benchmarks compares the implementations of the collections with each other and with Go's builtin maps
+// and slices.
+//
+// Every benchmark runs on several sizes, from 1e2 to 1e6 elements, and key distributions:
+//
+//	sequential   increasing keys, e.g. auto incremented ids
+//	random       distinct random keys
+//	adversarial  increasing keys that all land in the same HashMap bucket with types.IntHash, the worst case of
+//	             both unseeded hash maps and unbalanced trees
+//
+// Sub-benchmarks are named after their parameters, e.g. BenchmarkMapGet/dist=random/size=1000/impl=HashMap, so they
+// can be filtered with -bench and compared side by side with cmd/collbench:
+//
+//	go run ./cmd/collbench -bench 'Map/.*/size=10000/'
+//
+// The benchmarks report their allocations as well, which are compared with -metric, e.g. HashMap allocates a node
+// for every new key where FlatHashMap only allocates when it grows:
+//
+//	go run ./cmd/collbench -bench 'Map(Build|Insert)/.*/size=10000$/' -metric allocs/op
+//
+// Building some implementations from some distributions takes quadratic time, e.g. a BinaryTreeMap from sequential
+// keys, in which case the benchmarks past 1e4 elements are skipped.
+package benchmarks
@@ -0,0 +1,76 @@
+package benchmarks
+
+import (
+	"fmt"
+	"testing"
+	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
+)
+
+// BenchmarkFrozenHashMap compares the single probe lookups of the FrozenHashMap with the lookups of the other maps,
+// for keys in the map and keys that are not
+func BenchmarkFrozenHashMap(b *testing.B) {
+	impls := []struct {
+		name string
+		load func(entries []dict.Entry[int, int]) navigableMap
+	}{
+		{"FrozenHashMap", func(entries []dict.Entry[int, int]) navigableMap {
+			m, err := dict.MakeFrozenHashMap[int, int](types.IntegerHasher[int](types.MakeSeed()), dict.MakeFlatMapFrom(types.IntComparator, entries))
+			if err != nil {
+				panic(err)
+			}
+			return navigableFrozenHashMap{m}
+		}},
+		{"FrozenSortedMap", func(entries []dict.Entry[int, int]) navigableMap {
+			return dict.MakeFrozenSortedMap(types.IntComparator, entries)
+		}},
+		{"map", func(entries []dict.Entry[int, int]) navigableMap {
+			m := make(goMap, len(entries))
+			for _, entry := range entries {
+				m[entry.Key] = entry.Val
+			}
+			return navigableGoMap{m}
+		}},
+	}
+
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		for _, impl := range impls {
+			for _, op := range []string{"Get", "GetMiss"} {
+				impl, op := impl, op
+				b.Run(fmt.Sprintf("op=%s/impl=%s", op, impl.name), func(b *testing.B) {
+					m := cached(fmt.Sprintf("frozen/%s/%s/%d", impl.name, dist, size), func() navigableMap {
+						return impl.load(sortedEntries(dist, size))
+					})
+					ks := makeKeys(dist, size)
+					keys, found := ks.keys, true
+					if op == "GetMiss" {
+						keys, found = ks.missing, false
+					}
+					b.ResetTimer()
+					for i := 0; i < b.N; i++ {
+						if _, ok := m.Get(keys[i%len(keys)]); ok != found {
+							b.Fatalf("Get found %v instead of %v", ok, found)
+						}
+					}
+				})
+			}
+		}
+	})
+}
+
+// navigableFrozenHashMap and navigableGoMap only take part in the Get benchmarks, having no Floor
+type navigableFrozenHashMap struct {
+	*dict.FrozenHashMap[int, int]
+}
+
+func (navigableFrozenHashMap) Floor(int) (dict.Entry[int, int], bool) {
+	panic("benchmarks: FrozenHashMap has no Floor")
+}
+
+type navigableGoMap struct {
+	goMap
+}
+
+func (navigableGoMap) Floor(int) (dict.Entry[int, int], bool) {
+	panic("benchmarks: map has no Floor")
+}
@@ -0,0 +1,171 @@
+package benchmarks
+
+import (
+	"fmt"
+	"testing"
+	"utils-generics/collections/immutable"
+	"utils-generics/collections/types"
+)
+
+func buildImmutableHashMap(keys []int) *immutable.ImmutableHashMap[int, int] {
+	m := immutable.MakeImmutableHashMap[int, int](types.IntHash)
+	for _, key := range keys {
+		m = m.Put(key, key)
+	}
+	return m
+}
+
+func buildImmutableTreeMap(keys []int) *immutable.ImmutableTreeMap[int, int] {
+	m := immutable.MakeImmutableTreeMap[int, int](types.IntComparator)
+	for _, key := range keys {
+		m = m.Put(key, key)
+	}
+	return m
+}
+
+// BenchmarkImmutableMapGet compares the persistent maps with each other, their mutable counterparts are part
+// of BenchmarkMapGet
+func BenchmarkImmutableMapGet(b *testing.B) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		ks := makeKeys(dist, size)
+		b.Run("impl=ImmutableHashMap", func(b *testing.B) {
+			m := cached(fmt.Sprintf("immutable/hash/%s/%d", dist, size), func() *immutable.ImmutableHashMap[int, int] {
+				return buildImmutableHashMap(ks.keys)
+			})
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, ok := m.Get(ks.keys[i%size]); !ok {
+					b.Fatal("key not found")
+				}
+			}
+		})
+		b.Run("impl=ImmutableTreeMap", func(b *testing.B) {
+			m := cached(fmt.Sprintf("immutable/tree/%s/%d", dist, size), func() *immutable.ImmutableTreeMap[int, int] {
+				return buildImmutableTreeMap(ks.keys)
+			})
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, ok := m.Get(ks.keys[i%size]); !ok {
+					b.Fatal("key not found")
+				}
+			}
+		})
+	})
+}
+
+// BenchmarkImmutableMapPut puts a new key in the map, measuring the cost of copying the path to it
+func BenchmarkImmutableMapPut(b *testing.B) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		ks := makeKeys(dist, size)
+		b.Run("impl=ImmutableHashMap", func(b *testing.B) {
+			m := cached(fmt.Sprintf("immutable/hash/%s/%d", dist, size), func() *immutable.ImmutableHashMap[int, int] {
+				return buildImmutableHashMap(ks.keys)
+			})
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				key := ks.missing[i%size]
+				if m.Put(key, key).Size() != size+1 {
+					b.Fatal("key not added")
+				}
+			}
+		})
+		b.Run("impl=ImmutableTreeMap", func(b *testing.B) {
+			m := cached(fmt.Sprintf("immutable/tree/%s/%d", dist, size), func() *immutable.ImmutableTreeMap[int, int] {
+				return buildImmutableTreeMap(ks.keys)
+			})
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				key := ks.missing[i%size]
+				if m.Put(key, key).Size() != size+1 {
+					b.Fatal("key not added")
+				}
+			}
+		})
+	})
+}
+
+// BenchmarkImmutableMapBuild puts every key in the map one by one, keeping only the last version
+func BenchmarkImmutableMapBuild(b *testing.B) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		ks := makeKeys(dist, size)
+		b.Run("impl=ImmutableHashMap", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				buildImmutableHashMap(ks.keys)
+			}
+		})
+		b.Run("impl=ImmutableHashMapBuilder", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				builder := immutable.MakeImmutableHashMapBuilder[int, int](types.IntHash)
+				for _, key := range ks.keys {
+					builder.Put(key, key)
+				}
+				builder.Build()
+			}
+		})
+		b.Run("impl=ImmutableTreeMap", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				buildImmutableTreeMap(ks.keys)
+			}
+		})
+	})
+}
+
+// BenchmarkVector compares the operations of the Vector with those of a slice, which has to be copied to keep
+// the previous version when it is set
+func BenchmarkVector(b *testing.B) {
+	for _, size := range sizes {
+		size := size
+		values := makeKeys(sequential, size).keys
+		vector := func() *immutable.Vector[int] {
+			return cached(fmt.Sprintf("vector/%d", size), func() *immutable.Vector[int] {
+				return immutable.MakeVector(values...)
+			})
+		}
+
+		b.Run(fmt.Sprintf("op=Append/size=%d/impl=Vector", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				v := immutable.MakeVector[int]()
+				for _, val := range values {
+					v = v.Append(val)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("op=Append/size=%d/impl=slice", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var s []int
+				for _, val := range values {
+					s = append(s, val)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("op=Get/size=%d/impl=Vector", size), func(b *testing.B) {
+			vector := vector()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, ok := vector.Get(i % size); !ok {
+					b.Fatal("index not found")
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("op=Get/size=%d/impl=slice", size), func(b *testing.B) {
+			sum := 0
+			for i := 0; i < b.N; i++ {
+				sum += values[i%size]
+			}
+			_ = sum
+		})
+		b.Run(fmt.Sprintf("op=Set/size=%d/impl=Vector", size), func(b *testing.B) {
+			vector := vector()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				vector.Set(i%size, i)
+			}
+		})
+		b.Run(fmt.Sprintf("op=Set/size=%d/impl=slice", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				s := append([]int(nil), values...)
+				s[i%size] = i
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+const (
+	sequential  = "sequential"
+	random      = "random"
+	adversarial = "adversarial"
+
+	// quadraticLimit is the largest size built in quadratic time
+	quadraticLimit = 10_000
+)
+
+var (
+	sizes         = []int{100, 1_000, 10_000, 100_000, 1_000_000}
+	distributions = []string{sequential, random, adversarial}
+)
+
+// keySet holds the keys of a distribution in insertion order, along with keys that are not among them
+type keySet struct {
+	keys    []int
+	missing []int
+}
+
+var keyCache = map[string]keySet{}
+
+// makeKeys returns n keys of the distribution, the same ones on every call
+func makeKeys(dist string, n int) keySet {
+	name := fmt.Sprintf("%s/%d", dist, n)
+	if ks, ok := keyCache[name]; ok {
+		return ks
+	}
+
+	var ks keySet
+	switch dist {
+	case sequential:
+		for i := 0; i < n; i++ {
+			ks.keys = append(ks.keys, i)
+			ks.missing = append(ks.missing, n+i)
+		}
+	case random:
+		r := rand.New(rand.NewSource(int64(n)))
+		seen := map[int]bool{}
+		for len(ks.keys) < n {
+			if key := r.Int(); !seen[key] {
+				seen[key] = true
+				ks.keys = append(ks.keys, key)
+			}
+		}
+		for len(ks.missing) < n {
+			if key := r.Int(); !seen[key] {
+				ks.missing = append(ks.missing, key)
+			}
+		}
+	case adversarial:
+		// the keys are found by trial, one in 128 of them lands in bucket 0
+		for key := 0; len(ks.keys)+len(ks.missing) < 2*n; key++ {
+			if types.IntHash(key)%128 != 0 {
+				continue
+			}
+			if len(ks.keys) < n {
+				ks.keys = append(ks.keys, key)
+			} else {
+				ks.missing = append(ks.missing, key)
+			}
+		}
+	default:
+		panic("benchmarks: unknown distribution " + dist)
+	}
+
+	keyCache[name] = ks
+	return ks
+}
+
+// sorted returns a sorted copy of the keys
+func sorted(keys []int) []int {
+	s := append([]int(nil), keys...)
+	sort.Ints(s)
+	return s
+}
+
+// forEachCase runs the benchmark for every distribution and size
+func forEachCase(b *testing.B, run func(b *testing.B, dist string, size int)) {
+	for _, dist := range distributions {
+		for _, size := range sizes {
+			dist, size := dist, size
+			b.Run(fmt.Sprintf("dist=%s/size=%d", dist, size), func(b *testing.B) {
+				run(b, dist, size)
+			})
+		}
+	}
+}
+
+// cache holds the last structure returned by cached
+var cache struct {
+	name string
+	val  any
+}
+
+// cached returns the structure built last if it has the same name, or builds it otherwise.
+// Benchmarks on a structure leave it as they found it, so the sub-benchmarks of the same case share it instead
+// of building it again every time the testing package increases b.N.
+func cached[T any](name string, build func() T) T {
+	if cache.name != name {
+		cache.name, cache.val = "", nil
+		cache.val = build()
+		cache.name = name
+	}
+	return cache.val.(T)
+}
+
+// checkLimit skips the benchmark if building the structure from the distribution takes too long
+func checkLimit(b *testing.B, name, dist string, size, limit int) {
+	if size > limit {
+		b.Skipf("building %s from %d %s keys takes too long", name, size, dist)
+	}
+}
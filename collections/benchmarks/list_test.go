@@ -0,0 +1,173 @@
+package benchmarks
+
+import (
+	"fmt"
+	"testing"
+	"utils-generics/collections/list"
+)
+
+// goSlice is a list built on a slice
+type goSlice []int
+
+func (s *goSlice) Add(val int) {
+	*s = append(*s, val)
+}
+
+func (s *goSlice) Get(index int) (int, bool) {
+	if index < 0 || index >= len(*s) {
+		return 0, false
+	}
+	return (*s)[index], true
+}
+
+func (s *goSlice) Contains(val int) bool {
+	for _, v := range *s {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *goSlice) Size() int {
+	return len(*s)
+}
+
+// benchList is the common ground of the lists of the collections and slices
+type benchList interface {
+	Add(val int)
+	Get(index int) (int, bool)
+	Contains(val int) bool
+	Size() int
+}
+
+type listImpl struct {
+	name string
+	make func() benchList
+}
+
+var listImpls = []listImpl{
+	{name: "LinkedList", make: func() benchList { return list.MakeComparableLinkedList[int]() }},
+	{name: "DoubleLinkedList", make: func() benchList { return list.MakeComparableDoubleLinkedList[int]() }},
+	{name: "slice", make: func() benchList { return &goSlice{} }},
+}
+
+func (impl listImpl) build(keys []int) benchList {
+	l := impl.make()
+	for _, key := range keys {
+		l.Add(key)
+	}
+	return l
+}
+
+// benchmarkLists runs the operation on every list holding the keys of every distribution and size.
+// Lists do not depend on the values they hold, so only the sequential distribution is used.
+func benchmarkLists(b *testing.B, op func(b *testing.B, l benchList, ks keySet)) {
+	for _, size := range sizes {
+		size := size
+		for _, impl := range listImpls {
+			impl := impl
+			b.Run(fmt.Sprintf("size=%d/impl=%s", size, impl.name), func(b *testing.B) {
+				ks := makeKeys(sequential, size)
+				l := cached(fmt.Sprintf("list/%s/%d", impl.name, size), func() benchList {
+					return impl.build(ks.keys)
+				})
+				b.ResetTimer()
+				op(b, l, ks)
+			})
+		}
+	}
+}
+
+// BenchmarkListAdd adds every key to a new list, one by one
+func BenchmarkListAdd(b *testing.B) {
+	for _, size := range sizes {
+		size := size
+		for _, impl := range listImpls {
+			impl := impl
+			b.Run(fmt.Sprintf("size=%d/impl=%s", size, impl.name), func(b *testing.B) {
+				ks := makeKeys(sequential, size)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if l := impl.build(ks.keys); l.Size() != size {
+						b.Fatalf("built %d elements instead of %d", l.Size(), size)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkListGet(b *testing.B) {
+	benchmarkLists(b, func(b *testing.B, l benchList, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			if _, ok := l.Get(i % len(ks.keys)); !ok {
+				b.Fatal("index not found")
+			}
+		}
+	})
+}
+
+// BenchmarkListContains looks for an element in the middle of the list
+func BenchmarkListContains(b *testing.B) {
+	benchmarkLists(b, func(b *testing.B, l benchList, ks keySet) {
+		val := ks.keys[len(ks.keys)/2]
+		for i := 0; i < b.N; i++ {
+			if !l.Contains(val) {
+				b.Fatal("element not found")
+			}
+		}
+	})
+}
+
+// BenchmarkQueue enqueues and dequeues an element in a queue holding size elements
+func BenchmarkQueue(b *testing.B) {
+	for _, size := range sizes {
+		size := size
+		b.Run(fmt.Sprintf("size=%d/impl=SimpleQueue", size), func(b *testing.B) {
+			q := list.MakeSimpleQueue[int]()
+			for i := 0; i < size; i++ {
+				q.Enqueue(i)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				q.Enqueue(i)
+				q.Dequeue()
+			}
+		})
+		b.Run(fmt.Sprintf("size=%d/impl=slice", size), func(b *testing.B) {
+			q := make([]int, size)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				q = append(q, i)
+				q = q[1:]
+			}
+		})
+	}
+}
+
+// BenchmarkStack pushes and pops an element in a stack holding size elements
+func BenchmarkStack(b *testing.B) {
+	for _, size := range sizes {
+		size := size
+		b.Run(fmt.Sprintf("size=%d/impl=SimpleStack", size), func(b *testing.B) {
+			s := list.MakeSimpleStack[int]()
+			for i := 0; i < size; i++ {
+				s.Push(i)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.Push(i)
+				s.Pop()
+			}
+		})
+		b.Run(fmt.Sprintf("size=%d/impl=slice", size), func(b *testing.B) {
+			s := make([]int, size)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s = append(s, i)
+				s = s[:len(s)-1]
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+package benchmarks
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
+)
+
+// benchMap is the common ground of the maps of the collections and the builtin ones
+type benchMap interface {
+	Get(key int) (int, bool)
+	Put(key int, val int)
+	Remove(key int) bool
+	Len() int
+	ForEach(visit func(key, val int))
+}
+
+// dictMap adapts a dict.Map to benchMap
+type dictMap struct {
+	dict.Map[int, int]
+}
+
+func (m dictMap) Len() int {
+	return m.Size()
+}
+
+func (m dictMap) ForEach(visit func(key, val int)) {
+	for _, entry := range m.Entries() {
+		visit(entry.Key, entry.Val)
+	}
+}
+
+// goMap is the builtin map
+type goMap map[int]int
+
+func (m goMap) Get(key int) (int, bool) {
+	val, ok := m[key]
+	return val, ok
+}
+
+func (m goMap) Put(key int, val int) {
+	m[key] = val
+}
+
+func (m goMap) Remove(key int) bool {
+	_, ok := m[key]
+	delete(m, key)
+	return ok
+}
+
+func (m goMap) Len() int {
+	return len(m)
+}
+
+func (m goMap) ForEach(visit func(key, val int)) {
+	for key, val := range m {
+		visit(key, val)
+	}
+}
+
+// sortedSlice is a slice of entries sorted by key and searched with sort.Search
+type sortedSlice struct {
+	entries []dict.Entry[int, int]
+}
+
+func (s *sortedSlice) index(key int) (int, bool) {
+	i := sort.Search(len(s.entries), func(i int) bool { return s.entries[i].Key >= key })
+	return i, i < len(s.entries) && s.entries[i].Key == key
+}
+
+func (s *sortedSlice) Get(key int) (int, bool) {
+	if i, ok := s.index(key); ok {
+		return s.entries[i].Val, true
+	}
+	return 0, false
+}
+
+func (s *sortedSlice) Put(key int, val int) {
+	i, ok := s.index(key)
+	if ok {
+		s.entries[i].Val = val
+		return
+	}
+	s.entries = append(s.entries, dict.Entry[int, int]{})
+	copy(s.entries[i+1:], s.entries[i:])
+	s.entries[i] = dict.Entry[int, int]{Key: key, Val: val}
+}
+
+func (s *sortedSlice) Remove(key int) bool {
+	i, ok := s.index(key)
+	if ok {
+		s.entries = append(s.entries[:i], s.entries[i+1:]...)
+	}
+	return ok
+}
+
+func (s *sortedSlice) Len() int {
+	return len(s.entries)
+}
+
+func (s *sortedSlice) ForEach(visit func(key, val int)) {
+	for _, entry := range s.entries {
+		visit(entry.Key, entry.Val)
+	}
+}
+
+// mapImpl describes how to create, and possibly bulk load, one of the benchmarked maps
+type mapImpl struct {
+	name string
+	make func() benchMap
+	// load builds the map from entries sorted by key, when it is faster than putting them one by one
+	load func(entries []dict.Entry[int, int]) benchMap
+	// limit returns the largest size the map is built at with Put for the distribution,
+	// past which building it takes too long
+	limit func(dist string) int
+}
+
+func unlimited(string) int {
+	return sizes[len(sizes)-1]
+}
+
+// hashLimit is the limit of the hash tables: their number of buckets is fixed, so they grow linked lists
+// of n/128 entries, or of n entries with the adversarial keys that all land in the same bucket
+func hashLimit(dist string) int {
+	if dist == adversarial {
+		return quadraticLimit
+	}
+	return 10 * quadraticLimit
+}
+
+// treeLimit is the limit of the unbalanced trees, which increasing keys degenerate into a list
+func treeLimit(dist string) int {
+	if dist == random {
+		return unlimited(dist)
+	}
+	return quadraticLimit
+}
+
+// flatLimit is the limit of the sorted arrays, in which random keys are inserted in the middle,
+// shifting half of the array on average
+func flatLimit(dist string) int {
+	if dist == random {
+		return quadraticLimit
+	}
+	return unlimited(dist)
+}
+
+var mapImpls = []mapImpl{
+	{
+		name:  "HashMap",
+		make:  func() benchMap { return dictMap{dict.MakeComparableHashMap[int, int](types.IntHash)} },
+		limit: hashLimit,
+	},
+	{
+		name:  "DefaultHashMap",
+		make:  func() benchMap { return dictMap{dict.MakeDefaultHashMap[int, int]()} },
+		limit: func(string) int { return 10 * quadraticLimit }, // the seed does not help with the fixed buckets
+	},
+	{
+		name:  "FlatHashMap",
+		make:  func() benchMap { return dictMap{dict.MakeComparableFlatHashMap[int, int](types.IntHash)} },
+		limit: unlimited,
+	},
+	{
+		name:  "DefaultFlatHashMap",
+		make:  func() benchMap { return dictMap{dict.MakeDefaultFlatHashMap[int, int]()} },
+		limit: unlimited,
+	},
+	{
+		name: "BinaryTreeMap",
+		make: func() benchMap { return dictMap{dict.MakeBinaryTreeMap[int, int](types.IntComparator)} },
+		load: func(entries []dict.Entry[int, int]) benchMap {
+			m := dict.MakeBinaryTreeMap[int, int](types.IntComparator)
+			if err := m.LoadSorted(entries); err != nil {
+				panic(err)
+			}
+			return dictMap{m}
+		},
+		limit: treeLimit,
+	},
+	{
+		name: "FlatMap",
+		make: func() benchMap { return dictMap{dict.MakeFlatMap[int, int](types.IntComparator)} },
+		load: func(entries []dict.Entry[int, int]) benchMap {
+			m := dict.MakeFlatMap[int, int](types.IntComparator)
+			if err := m.LoadSorted(entries); err != nil {
+				panic(err)
+			}
+			return dictMap{m}
+		},
+		limit: flatLimit,
+	},
+	{
+		name: "SkipListMap",
+		make: func() benchMap { return dictMap{dict.MakeSkipListMapWithSeed[int, int](types.IntComparator, 1)} },
+		load: func(entries []dict.Entry[int, int]) benchMap {
+			m := dict.MakeSkipListMapWithSeed[int, int](types.IntComparator, 1)
+			if err := m.LoadSorted(entries); err != nil {
+				panic(err)
+			}
+			return dictMap{m}
+		},
+		limit: unlimited,
+	},
+	{
+		name:  "map",
+		make:  func() benchMap { return goMap{} },
+		limit: unlimited,
+	},
+	{
+		name: "SortedSlice",
+		make: func() benchMap { return &sortedSlice{} },
+		load: func(entries []dict.Entry[int, int]) benchMap {
+			return &sortedSlice{entries: entries}
+		},
+		limit: flatLimit,
+	},
+}
+
+// build puts the keys in a new map one by one
+func (impl mapImpl) build(keys []int) benchMap {
+	m := impl.make()
+	for _, key := range keys {
+		m.Put(key, key)
+	}
+	return m
+}
+
+// loadMap returns the map holding the keys of the distribution, or skips the benchmark if it takes too long to build
+func loadMap(b *testing.B, impl mapImpl, dist string, size int) (benchMap, keySet) {
+	ks := makeKeys(dist, size)
+	if impl.load == nil {
+		checkLimit(b, impl.name, dist, size, impl.limit(dist))
+	}
+
+	m := cached(fmt.Sprintf("map/%s/%s/%d", impl.name, dist, size), func() benchMap {
+		if impl.load == nil {
+			return impl.build(ks.keys)
+		}
+		entries := make([]dict.Entry[int, int], size)
+		for i, key := range sorted(ks.keys) {
+			entries[i] = dict.Entry[int, int]{Key: key, Val: key}
+		}
+		return impl.load(entries)
+	})
+	return m, ks
+}
+
+// benchmarkMaps runs the operation on every map for every distribution and size
+func benchmarkMaps(b *testing.B, op func(b *testing.B, m benchMap, ks keySet)) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		for _, impl := range mapImpls {
+			impl := impl
+			b.Run("impl="+impl.name, func(b *testing.B) {
+				m, ks := loadMap(b, impl, dist, size)
+				b.ResetTimer()
+				op(b, m, ks)
+			})
+		}
+	})
+}
+
+func BenchmarkMapGet(b *testing.B) {
+	benchmarkMaps(b, func(b *testing.B, m benchMap, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			if _, ok := m.Get(ks.keys[i%len(ks.keys)]); !ok {
+				b.Fatal("key not found")
+			}
+		}
+	})
+}
+
+func BenchmarkMapGetMiss(b *testing.B) {
+	benchmarkMaps(b, func(b *testing.B, m benchMap, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			if _, ok := m.Get(ks.missing[i%len(ks.missing)]); ok {
+				b.Fatal("missing key found")
+			}
+		}
+	})
+}
+
+// BenchmarkMapPut overwrites the value of existing keys
+func BenchmarkMapPut(b *testing.B) {
+	benchmarkMaps(b, func(b *testing.B, m benchMap, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			key := ks.keys[i%len(ks.keys)]
+			m.Put(key, key)
+		}
+	})
+}
+
+// BenchmarkMapInsert puts a new key and removes it, leaving the map as it was
+func BenchmarkMapInsert(b *testing.B) {
+	benchmarkMaps(b, func(b *testing.B, m benchMap, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			key := ks.missing[i%len(ks.missing)]
+			m.Put(key, key)
+			if !m.Remove(key) {
+				b.Fatal("inserted key not found")
+			}
+		}
+	})
+}
+
+func BenchmarkMapIterate(b *testing.B) {
+	benchmarkMaps(b, func(b *testing.B, m benchMap, ks keySet) {
+		sum := 0
+		for i := 0; i < b.N; i++ {
+			m.ForEach(func(key, val int) { sum += val })
+		}
+		_ = sum
+	})
+}
+
+// BenchmarkMapBuild puts every key in a new map, one by one
+func BenchmarkMapBuild(b *testing.B) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		for _, impl := range mapImpls {
+			impl := impl
+			b.Run("impl="+impl.name, func(b *testing.B) {
+				checkLimit(b, impl.name, dist, size, impl.limit(dist))
+				ks := makeKeys(dist, size)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if m := impl.build(ks.keys); m.Len() != size {
+						b.Fatalf("built %d entries instead of %d", m.Len(), size)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestSortedSlice(t *testing.T) {
+	s := &sortedSlice{}
+	for _, key := range []int{5, 1, 3, 1} {
+		s.Put(key, key*10)
+	}
+
+	assert.Equal(t, 3, s.Len())
+	val, ok := s.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, 30, val)
+	_, ok = s.Get(2)
+	assert.False(t, ok)
+	assert.True(t, s.Remove(1))
+	assert.False(t, s.Remove(1))
+
+	var keys []int
+	s.ForEach(func(key, val int) { keys = append(keys, key) })
+	assert.Equal(t, []int{3, 5}, keys)
+}
+
+// BenchmarkFlatMapFrom compares building a FlatMap one key at a time with sorting the keys once
+func BenchmarkFlatMapFrom(b *testing.B) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		b.Run("impl=Put", func(b *testing.B) {
+			checkLimit(b, "FlatMap", dist, size, flatLimit(dist))
+			ks := makeKeys(dist, size)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				m := dict.MakeFlatMap[int, int](types.IntComparator)
+				for _, key := range ks.keys {
+					m.Put(key, key)
+				}
+			}
+		})
+		b.Run("impl=MakeFlatMapFrom", func(b *testing.B) {
+			ks := makeKeys(dist, size)
+			entries := make([]dict.Entry[int, int], size)
+			for i, key := range ks.keys {
+				entries[i] = dict.Entry[int, int]{Key: key, Val: key}
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				dict.MakeFlatMapFrom(types.IntComparator, entries)
+			}
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package benchmarks
+
+import (
+	"fmt"
+	"testing"
+	"utils-generics/collections/set"
+	"utils-generics/collections/types"
+)
+
+// goSet is a set built on the builtin map
+type goSet map[int]struct{}
+
+func (s goSet) Add(val int) {
+	s[val] = struct{}{}
+}
+
+func (s goSet) Remove(val int) bool {
+	_, ok := s[val]
+	delete(s, val)
+	return ok
+}
+
+func (s goSet) Contains(val int) bool {
+	_, ok := s[val]
+	return ok
+}
+
+func (s goSet) Size() int {
+	return len(s)
+}
+
+// benchSet is the common ground of the sets of the collections and the builtin ones
+type benchSet interface {
+	Add(val int)
+	Remove(val int) bool
+	Contains(val int) bool
+	Size() int
+}
+
+// setImpl describes how to create one of the benchmarked sets, see mapImpl
+type setImpl struct {
+	name  string
+	make  func() benchSet
+	limit func(dist string) int
+}
+
+var setImpls = []setImpl{
+	{name: "HashSet", make: func() benchSet { return set.MakeComparableHashSet[int](types.IntHash) }, limit: hashLimit},
+	{name: "BinaryTreeSet", make: func() benchSet { return set.MakeBinaryTreeSet[int](types.IntComparator) }, limit: treeLimit},
+	{name: "FlatSet", make: func() benchSet { return set.MakeFlatSet[int](types.IntComparator) }, limit: flatLimit},
+	{name: "SkipListSet", make: func() benchSet { return set.MakeSkipListSetWithSeed[int](types.IntComparator, 1) }, limit: unlimited},
+	{name: "map", make: func() benchSet { return goSet{} }, limit: unlimited},
+}
+
+func (impl setImpl) build(keys []int) benchSet {
+	s := impl.make()
+	for _, key := range keys {
+		s.Add(key)
+	}
+	return s
+}
+
+// benchmarkSets runs the operation on every set holding the keys of every distribution and size
+func benchmarkSets(b *testing.B, op func(b *testing.B, s benchSet, ks keySet)) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		for _, impl := range setImpls {
+			impl := impl
+			b.Run("impl="+impl.name, func(b *testing.B) {
+				checkLimit(b, impl.name, dist, size, impl.limit(dist))
+				ks := makeKeys(dist, size)
+				s := cached(fmt.Sprintf("set/%s/%s/%d", impl.name, dist, size), func() benchSet {
+					return impl.build(ks.keys)
+				})
+				b.ResetTimer()
+				op(b, s, ks)
+			})
+		}
+	})
+}
+
+func BenchmarkSetContains(b *testing.B) {
+	benchmarkSets(b, func(b *testing.B, s benchSet, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			if !s.Contains(ks.keys[i%len(ks.keys)]) {
+				b.Fatal("element not found")
+			}
+		}
+	})
+}
+
+// BenchmarkSetAdd adds a new element and removes it, leaving the set as it was
+func BenchmarkSetAdd(b *testing.B) {
+	benchmarkSets(b, func(b *testing.B, s benchSet, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			val := ks.missing[i%len(ks.missing)]
+			s.Add(val)
+			if !s.Remove(val) {
+				b.Fatal("added element not found")
+			}
+		}
+	})
+}
+
+// BenchmarkSetBuild adds every key to a new set, one by one
+func BenchmarkSetBuild(b *testing.B) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		for _, impl := range setImpls {
+			impl := impl
+			b.Run("impl="+impl.name, func(b *testing.B) {
+				checkLimit(b, impl.name, dist, size, impl.limit(dist))
+				ks := makeKeys(dist, size)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if s := impl.build(ks.keys); s.Size() != size {
+						b.Fatalf("built %d elements instead of %d", s.Size(), size)
+					}
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package benchmarks
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
+)
+
+// sortedEntries returns the entries of the keys of the distribution, sorted by key
+func sortedEntries(dist string, size int) []dict.Entry[int, int] {
+	entries := make([]dict.Entry[int, int], size)
+	for i, key := range sorted(makeKeys(dist, size).keys) {
+		entries[i] = dict.Entry[int, int]{Key: key, Val: key}
+	}
+	return entries
+}
+
+// navigableMap is the common ground of the read-only sorted maps
+type navigableMap interface {
+	Get(key int) (int, bool)
+	Floor(key int) (dict.Entry[int, int], bool)
+}
+
+// sortedSliceFloor adds Floor to sortedSlice
+type sortedSliceFloor struct {
+	sortedSlice
+}
+
+func (s *sortedSliceFloor) Floor(key int) (dict.Entry[int, int], bool) {
+	i := sort.Search(len(s.entries), func(i int) bool { return s.entries[i].Key > key })
+	if i == 0 {
+		return dict.Entry[int, int]{}, false
+	}
+	return s.entries[i-1], true
+}
+
+var navigableImpls = []struct {
+	name string
+	load func(entries []dict.Entry[int, int]) navigableMap
+}{
+	{"FrozenSortedMap", func(entries []dict.Entry[int, int]) navigableMap {
+		return dict.MakeFrozenSortedMap(types.IntComparator, entries)
+	}},
+	{"BinaryTreeMap", func(entries []dict.Entry[int, int]) navigableMap {
+		m := dict.MakeBinaryTreeMap[int, int](types.IntComparator)
+		if err := m.LoadSorted(entries); err != nil {
+			panic(err)
+		}
+		return m
+	}},
+	{"SkipListMap", func(entries []dict.Entry[int, int]) navigableMap {
+		m := dict.MakeSkipListMapWithSeed[int, int](types.IntComparator, 1)
+		if err := m.LoadSorted(entries); err != nil {
+			panic(err)
+		}
+		return m
+	}},
+	{"SortedSlice", func(entries []dict.Entry[int, int]) navigableMap {
+		return &sortedSliceFloor{sortedSlice{entries: entries}}
+	}},
+}
+
+// benchmarkNavigable runs the lookup on the read-only sorted maps and, for Get, on the FlatMap as well
+func benchmarkNavigable(b *testing.B, withFlatMap bool, lookup func(b *testing.B, m navigableMap, ks keySet)) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		for _, impl := range navigableImpls {
+			impl := impl
+			b.Run("impl="+impl.name, func(b *testing.B) {
+				m := cached(fmt.Sprintf("navigable/%s/%s/%d", impl.name, dist, size), func() navigableMap {
+					return impl.load(sortedEntries(dist, size))
+				})
+				b.ResetTimer()
+				lookup(b, m, makeKeys(dist, size))
+			})
+		}
+		if !withFlatMap {
+			return
+		}
+		b.Run("impl=FlatMap", func(b *testing.B) {
+			m := cached(fmt.Sprintf("navigable/FlatMap/%s/%d", dist, size), func() navigableMap {
+				return navigableFlatMap{dict.MakeFlatMapFrom(types.IntComparator, sortedEntries(dist, size))}
+			})
+			b.ResetTimer()
+			lookup(b, m, makeKeys(dist, size))
+		})
+	})
+}
+
+// navigableFlatMap lets the FlatMap, which has no Floor, take part in the Get benchmark
+type navigableFlatMap struct {
+	*dict.FlatMap[int, int]
+}
+
+func (navigableFlatMap) Floor(int) (dict.Entry[int, int], bool) {
+	panic("benchmarks: FlatMap has no Floor")
+}
+
+// BenchmarkSortedGet compares the lookups of the sorted maps, laid out in Eytzinger order or as a sorted array
+func BenchmarkSortedGet(b *testing.B) {
+	benchmarkNavigable(b, true, func(b *testing.B, m navigableMap, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			if _, ok := m.Get(ks.keys[i%len(ks.keys)]); !ok {
+				b.Fatal("key not found")
+			}
+		}
+	})
+}
+
+// BenchmarkSortedFloor looks for the keys that are not in the maps, which sit between the keys that are
+func BenchmarkSortedFloor(b *testing.B) {
+	benchmarkNavigable(b, false, func(b *testing.B, m navigableMap, ks keySet) {
+		for i := 0; i < b.N; i++ {
+			m.Floor(ks.missing[i%len(ks.missing)])
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package benchmarks
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"utils-generics/collections/extra"
+)
+
+// makeWords returns the keys of the distribution written in base 36
+func makeWords(dist string, size int) []string {
+	keys := makeKeys(dist, size).keys
+	words := make([]string, len(keys))
+	for i, key := range keys {
+		words[i] = strconv.FormatInt(int64(key), 36)
+	}
+	return words
+}
+
+func buildTrie(words []string) *extra.Trie {
+	t := extra.MakeTrie()
+	for _, word := range words {
+		t.Add(word)
+	}
+	return t
+}
+
+// BenchmarkTrie compares the Trie with a builtin map of the words, for which suggestions are found
+// by a linear scan of the keys
+func BenchmarkTrie(b *testing.B) {
+	forEachCase(b, func(b *testing.B, dist string, size int) {
+		words := makeWords(dist, size)
+		trie := func() *extra.Trie {
+			return cached(fmt.Sprintf("trie/%s/%d", dist, size), func() *extra.Trie { return buildTrie(words) })
+		}
+		set := func() map[string]struct{} {
+			return cached(fmt.Sprintf("trie/map/%s/%d", dist, size), func() map[string]struct{} {
+				set := make(map[string]struct{}, size)
+				for _, word := range words {
+					set[word] = struct{}{}
+				}
+				return set
+			})
+		}
+		prefix := words[len(words)/2][:1]
+
+		b.Run("op=Add/impl=Trie", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				buildTrie(words)
+			}
+		})
+		b.Run("op=Contains/impl=Trie", func(b *testing.B) {
+			trie := trie()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if !trie.Contains(words[i%size]) {
+					b.Fatal("word not found")
+				}
+			}
+		})
+		b.Run("op=Suggestions/impl=Trie", func(b *testing.B) {
+			trie := trie()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				trie.Suggestions(prefix)
+			}
+		})
+		b.Run("op=Contains/impl=map", func(b *testing.B) {
+			set := set()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, ok := set[words[i%size]]; !ok {
+					b.Fatal("word not found")
+				}
+			}
+		})
+		b.Run("op=Suggestions/impl=map", func(b *testing.B) {
+			set := set()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var suggestions []string
+				for word := range set {
+					if len(word) >= len(prefix) && word[:len(prefix)] == prefix {
+						suggestions = append(suggestions, word)
+					}
+				}
+			}
+		})
+	})
+}
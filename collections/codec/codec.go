@@ -0,0 +1,209 @@
+// Package codec defines the compact binary format of the collections and the codecs of their elements.
+//
+// Every encoded collection starts with a header made of the format version, the layout of its elements and
+// their number, followed by the elements themselves:
+//
+//	version (1 byte) | layout (1 byte) | count (uvarint) | elements...
+//
+// Each element is encoded by a Codec: the built-in codecs cover strings, byte slices and the numeric and boolean
+// types, any other type falls back to encoding/gob unless the collection is given a dedicated Codec.
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Version is the version of the binary format written by this package
+const Version byte = 1
+
+// Layout tells how the elements of a collection are laid out after the header
+type Layout byte
+
+const (
+	// Values is the layout of lists, sets and the like: one value per element
+	Values Layout = iota + 1
+	// Entries is the layout of maps: a key followed by its value per element
+	Entries
+	// Counted is the layout of multisets: a value followed by its number of occurrences as an uvarint per element
+	Counted
+)
+
+func (l Layout) String() string {
+	switch l {
+	case Values:
+		return "values"
+	case Entries:
+		return "entries"
+	case Counted:
+		return "counted"
+	}
+	return fmt.Sprintf("Layout(%d)", byte(l))
+}
+
+// ErrTruncated is returned when the data ends in the middle of an element
+var ErrTruncated = errors.New("codec: truncated data")
+
+// Codec encodes and decodes values of type T. Every encoded value must take at least one byte.
+type Codec[T any] interface {
+	// Append appends the encoding of the value to buf and returns the extended buffer
+	Append(buf []byte, val T) ([]byte, error)
+	// Decode decodes a value from the beginning of data, returning it along with the number of bytes read
+	Decode(data []byte) (T, int, error)
+}
+
+// ----------------
+// Encoder
+
+// Encoder writes a collection in the binary format. Errors are sticky: once writing fails, the following
+// writes are no-ops and Bytes returns the error.
+type Encoder struct {
+	buf []byte
+	err error
+}
+
+// NewEncoder creates an Encoder and writes the header for n elements of the given layout
+func NewEncoder(layout Layout, n int) *Encoder {
+	buf := make([]byte, 0, 16)
+	buf = append(buf, Version, byte(layout))
+	buf = binary.AppendUvarint(buf, uint64(n))
+	return &Encoder{buf: buf}
+}
+
+// Write appends a value to the encoder using the given codec
+func Write[T any](e *Encoder, c Codec[T], val T) {
+	if e.err != nil {
+		return
+	}
+	e.buf, e.err = c.Append(e.buf, val)
+}
+
+// WriteCount appends the number of occurrences of an element of the Counted layout
+func (e *Encoder) WriteCount(n int) {
+	if e.err != nil {
+		return
+	}
+	e.buf = binary.AppendUvarint(e.buf, uint64(n))
+}
+
+// Bytes returns the encoded collection, or the first error met while writing it
+func (e *Encoder) Bytes() ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// ----------------
+// Decoder
+
+// Decoder reads a collection in the binary format. Like for the Encoder, errors are sticky: once reading fails,
+// the following reads return zero values and Finish returns the error.
+type Decoder struct {
+	data []byte
+	err  error
+}
+
+// NewDecoder reads the header of the data, checking its version and layout, and returns a Decoder
+// positioned on the first element along with the number of elements
+func NewDecoder(data []byte, layout Layout) (*Decoder, int, error) {
+	if len(data) < 2 {
+		return nil, 0, ErrTruncated
+	}
+	if data[0] != Version {
+		return nil, 0, fmt.Errorf("codec: unsupported format version %d", data[0])
+	}
+	if Layout(data[1]) != layout {
+		return nil, 0, fmt.Errorf("codec: expected the %s layout, got %s", layout, Layout(data[1]))
+	}
+
+	n, read := binary.Uvarint(data[2:])
+	if read <= 0 {
+		return nil, 0, ErrTruncated
+	}
+	if n > uint64(len(data)) {
+		// every element takes at least a byte, this is garbage and would make callers allocate for nothing
+		return nil, 0, fmt.Errorf("codec: %d elements cannot fit in %d bytes", n, len(data))
+	}
+	return &Decoder{data: data[2+read:]}, int(n), nil
+}
+
+// Read decodes the next value using the given codec
+func Read[T any](d *Decoder, c Codec[T]) T {
+	var zero T
+	if d.err != nil {
+		return zero
+	}
+	val, n, err := c.Decode(d.data)
+	if err != nil {
+		d.err = err
+		return zero
+	}
+	d.data = d.data[n:]
+	return val
+}
+
+// ReadCount decodes the number of occurrences of an element of the Counted layout
+func (d *Decoder) ReadCount() int {
+	if d.err != nil {
+		return 0
+	}
+	n, read := binary.Uvarint(d.data)
+	if read <= 0 {
+		d.err = ErrTruncated
+		return 0
+	}
+	if n > math.MaxInt {
+		d.err = fmt.Errorf("codec: count %d overflows int", n)
+		return 0
+	}
+	d.data = d.data[read:]
+	return int(n)
+}
+
+// Err returns the first error met while reading
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// Finish returns the first error met while reading, or an error if there is data left after the last element
+func (d *Decoder) Finish() error {
+	if d.err != nil {
+		return d.err
+	}
+	if len(d.data) != 0 {
+		return fmt.Errorf("codec: %d unexpected bytes after the last element", len(d.data))
+	}
+	return nil
+}
+
+// ----------------
+// Helpers
+
+// EncodeValues encodes values in the Values layout, keeping their order
+func EncodeValues[T any](values []T, c Codec[T]) ([]byte, error) {
+	e := NewEncoder(Values, len(values))
+	for _, val := range values {
+		Write(e, c, val)
+	}
+	return e.Bytes()
+}
+
+// DecodeValues decodes values encoded by EncodeValues, in the order they appear in the data
+func DecodeValues[T any](data []byte, c Codec[T]) ([]T, error) {
+	d, n, err := NewDecoder(data, Values)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]T, 0, n)
+	for i := 0; i < n && d.Err() == nil; i++ {
+		values = append(values, Read(d, c))
+	}
+	if err := d.Finish(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,144 @@
+package codec
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+func roundTrip[T any](t *testing.T, c Codec[T], values ...T) {
+	for _, val := range values {
+		buf, err := c.Append([]byte{0xff}, val)
+		assert.NoError(t, err)
+		assert.Equal(t, byte(0xff), buf[0], "Append must extend the buffer")
+
+		decoded, n, err := c.Decode(append(buf[1:], 0xee))
+		assert.NoError(t, err)
+		assert.Equal(t, len(buf)-1, n)
+		assert.Equal(t, val, decoded)
+	}
+}
+
+func TestCodecs_RoundTrip(t *testing.T) {
+	roundTrip(t, String[string](), "", "hello", "ünïcode")
+	roundTrip(t, Bytes(), []byte{}, []byte{0, 1, 2})
+	roundTrip(t, Varint[int](), 0, -1, 1, math.MinInt, math.MaxInt)
+	roundTrip(t, Varint[int8](), math.MinInt8, math.MaxInt8)
+	roundTrip(t, Uvarint[uint64](), 0, math.MaxUint64)
+	roundTrip(t, Uvarint[uint8](), 0, math.MaxUint8)
+	roundTrip(t, Float32[float32](), 0, -1.5, float32(math.Inf(1)))
+	roundTrip(t, Float64[float64](), 0, math.SmallestNonzeroFloat64, math.Inf(-1))
+	roundTrip(t, Complex64[complex64](), complex(1, -2))
+	roundTrip(t, Complex128[complex128](), complex(math.Pi, math.E))
+	roundTrip(t, Bool[bool](), true, false)
+	roundTrip(t, Gob[point](), point{}, point{1, -2})
+}
+
+func TestDefault(t *testing.T) {
+	roundTrip(t, Default[string](), "a")
+	roundTrip(t, Default[int32](), math.MinInt32)
+	roundTrip(t, Default[uintptr](), 42)
+	roundTrip(t, Default[[]byte](), []byte("abc"))
+	roundTrip(t, Default[point](), point{3, 4})
+	roundTrip(t, Default[map[string]int](), map[string]int{"a": 1})
+
+	buf, _ := Default[int]().Append(nil, -1)
+	assert.Equal(t, []byte{1}, buf, "small ints take a single byte")
+}
+
+func TestCodecs_Truncated(t *testing.T) {
+	_, _, err := String[string]().Decode([]byte{5, 'a'})
+	assert.ErrorIs(t, err, ErrTruncated)
+	_, _, err = Float64[float64]().Decode([]byte{1, 2, 3})
+	assert.ErrorIs(t, err, ErrTruncated)
+	_, _, err = Varint[int]().Decode([]byte{0x80})
+	assert.ErrorIs(t, err, ErrTruncated)
+	_, _, err = Bool[bool]().Decode(nil)
+	assert.ErrorIs(t, err, ErrTruncated)
+}
+
+func TestCodecs_Overflow(t *testing.T) {
+	buf, _ := Varint[int]().Append(nil, 200)
+	_, _, err := Varint[int8]().Decode(buf)
+	assert.Error(t, err)
+
+	buf, _ = Uvarint[uint]().Append(nil, 256)
+	_, _, err = Uvarint[uint8]().Decode(buf)
+	assert.Error(t, err)
+
+	_, _, err = Bool[bool]().Decode([]byte{2})
+	assert.Error(t, err)
+}
+
+func TestEncodeValues_Format(t *testing.T) {
+	data, err := EncodeValues([]string{"a", "bc"}, String[string]())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{Version, byte(Values), 2, 1, 'a', 2, 'b', 'c'}, data)
+}
+
+func TestDecodeValues(t *testing.T) {
+	data, _ := EncodeValues([]int{1, -2, 3}, Varint[int]())
+
+	values, err := DecodeValues(data, Varint[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, -2, 3}, values)
+
+	empty, _ := EncodeValues([]int(nil), Varint[int]())
+	values, err = DecodeValues(empty, Varint[int]())
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestDecodeValues_Errors(t *testing.T) {
+	data, _ := EncodeValues([]int{1, 2}, Varint[int]())
+
+	_, err := DecodeValues(data[:len(data)-1], Varint[int]())
+	assert.ErrorIs(t, err, ErrTruncated)
+
+	_, err = DecodeValues(append(data, 0), Varint[int]())
+	assert.Error(t, err, "trailing data")
+
+	_, err = DecodeValues(append([]byte{Version + 1}, data[1:]...), Varint[int]())
+	assert.Error(t, err, "unknown version")
+
+	_, err = DecodeValues(append([]byte{Version, byte(Entries)}, data[2:]...), Varint[int]())
+	assert.Error(t, err, "wrong layout")
+
+	_, err = DecodeValues([]byte{Version, byte(Values), 0xff, 0xff, 0xff, 0x7f}, Varint[int]())
+	assert.Error(t, err, "count larger than the data")
+
+	_, err = DecodeValues(nil, Varint[int]())
+	assert.ErrorIs(t, err, ErrTruncated)
+}
+
+func TestEncoder_Counted(t *testing.T) {
+	e := NewEncoder(Counted, 1)
+	Write(e, String[string](), "x")
+	e.WriteCount(300)
+	data, err := e.Bytes()
+	assert.NoError(t, err)
+
+	d, n, err := NewDecoder(data, Counted)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "x", Read(d, String[string]()))
+	assert.Equal(t, 300, d.ReadCount())
+	assert.NoError(t, d.Finish())
+}
+
+func TestEncoder_StickyError(t *testing.T) {
+	failing := MakeCodec(func(buf []byte, val int) ([]byte, error) {
+		return nil, assert.AnError
+	}, Varint[int]().Decode)
+
+	e := NewEncoder(Values, 2)
+	Write(e, failing, 1)
+	Write(e, Varint[int](), 2)
+	_, err := e.Bytes()
+	assert.ErrorIs(t, err, assert.AnError)
+}
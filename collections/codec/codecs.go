@@ -0,0 +1,252 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// Signed is the set of the signed integer types
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is the set of the unsigned integer types
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+type funcCodec[T any] struct {
+	append func(buf []byte, val T) ([]byte, error)
+	decode func(data []byte) (T, int, error)
+}
+
+func (c funcCodec[T]) Append(buf []byte, val T) ([]byte, error) {
+	return c.append(buf, val)
+}
+
+func (c funcCodec[T]) Decode(data []byte) (T, int, error) {
+	return c.decode(data)
+}
+
+// MakeCodec builds a Codec out of an append function and a decode function
+func MakeCodec[T any](append func(buf []byte, val T) ([]byte, error), decode func(data []byte) (T, int, error)) Codec[T] {
+	return funcCodec[T]{append: append, decode: decode}
+}
+
+// String returns a Codec for strings, encoded as their length as an uvarint followed by their bytes
+func String[T ~string]() Codec[T] {
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		buf = binary.AppendUvarint(buf, uint64(len(val)))
+		return append(buf, val...), nil
+	}, func(data []byte) (T, int, error) {
+		b, n, err := decodeBytes(data)
+		return T(b), n, err
+	})
+}
+
+// Bytes returns a Codec for byte slices, encoded like strings. A nil slice decodes as an empty one.
+func Bytes() Codec[[]byte] {
+	return MakeCodec(func(buf []byte, val []byte) ([]byte, error) {
+		buf = binary.AppendUvarint(buf, uint64(len(val)))
+		return append(buf, val...), nil
+	}, func(data []byte) ([]byte, int, error) {
+		b, n, err := decodeBytes(data)
+		return append([]byte{}, b...), n, err
+	})
+}
+
+func decodeBytes(data []byte) ([]byte, int, error) {
+	size, read := binary.Uvarint(data)
+	if read <= 0 || size > uint64(len(data)-read) {
+		return nil, 0, ErrTruncated
+	}
+	end := read + int(size)
+	return data[read:end], end, nil
+}
+
+// Varint returns a Codec for signed integers, zig-zag encoded as varints so that small magnitudes take few bytes
+func Varint[T Signed]() Codec[T] {
+	bits := int(unsafe.Sizeof(T(0))) * 8
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		return binary.AppendVarint(buf, int64(val)), nil
+	}, func(data []byte) (T, int, error) {
+		v, read := binary.Varint(data)
+		if read <= 0 {
+			return 0, 0, ErrTruncated
+		}
+		if bits < 64 && (v < -(1<<(bits-1)) || v >= 1<<(bits-1)) {
+			return 0, 0, fmt.Errorf("codec: %d overflows a %d bits integer", v, bits)
+		}
+		return T(v), read, nil
+	})
+}
+
+// Uvarint returns a Codec for unsigned integers, encoded as uvarints
+func Uvarint[T Unsigned]() Codec[T] {
+	bits := int(unsafe.Sizeof(T(0))) * 8
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		return binary.AppendUvarint(buf, uint64(val)), nil
+	}, func(data []byte) (T, int, error) {
+		v, read := binary.Uvarint(data)
+		if read <= 0 {
+			return 0, 0, ErrTruncated
+		}
+		if bits < 64 && v >= 1<<bits {
+			return 0, 0, fmt.Errorf("codec: %d overflows a %d bits unsigned integer", v, bits)
+		}
+		return T(v), read, nil
+	})
+}
+
+// Float32 returns a Codec for float32 values, encoded as their 4 bytes IEEE 754 representation
+func Float32[T ~float32]() Codec[T] {
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(val))), nil
+	}, func(data []byte) (T, int, error) {
+		if len(data) < 4 {
+			return 0, 0, ErrTruncated
+		}
+		return T(math.Float32frombits(binary.LittleEndian.Uint32(data))), 4, nil
+	})
+}
+
+// Float64 returns a Codec for float64 values, encoded as their 8 bytes IEEE 754 representation
+func Float64[T ~float64]() Codec[T] {
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(float64(val))), nil
+	}, func(data []byte) (T, int, error) {
+		if len(data) < 8 {
+			return 0, 0, ErrTruncated
+		}
+		return T(math.Float64frombits(binary.LittleEndian.Uint64(data))), 8, nil
+	})
+}
+
+// Complex64 returns a Codec for complex64 values, encoded as their real part followed by their imaginary part
+func Complex64[T ~complex64]() Codec[T] {
+	f := Float32[float32]()
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		buf, _ = f.Append(buf, real(complex64(val)))
+		return f.Append(buf, imag(complex64(val)))
+	}, func(data []byte) (T, int, error) {
+		if len(data) < 8 {
+			return 0, 0, ErrTruncated
+		}
+		r, _, _ := f.Decode(data)
+		i, _, _ := f.Decode(data[4:])
+		return T(complex(r, i)), 8, nil
+	})
+}
+
+// Complex128 returns a Codec for complex128 values, encoded as their real part followed by their imaginary part
+func Complex128[T ~complex128]() Codec[T] {
+	f := Float64[float64]()
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		buf, _ = f.Append(buf, real(complex128(val)))
+		return f.Append(buf, imag(complex128(val)))
+	}, func(data []byte) (T, int, error) {
+		if len(data) < 16 {
+			return 0, 0, ErrTruncated
+		}
+		r, _, _ := f.Decode(data)
+		i, _, _ := f.Decode(data[8:])
+		return T(complex(r, i)), 16, nil
+	})
+}
+
+// Bool returns a Codec for booleans, encoded as a single byte
+func Bool[T ~bool]() Codec[T] {
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		if val {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	}, func(data []byte) (T, int, error) {
+		if len(data) < 1 {
+			return false, 0, ErrTruncated
+		}
+		switch data[0] {
+		case 0:
+			return false, 1, nil
+		case 1:
+			return true, 1, nil
+		}
+		return false, 0, fmt.Errorf("codec: invalid boolean byte %d", data[0])
+	})
+}
+
+// Gob returns a Codec encoding each value with encoding/gob, length-prefixed like byte slices.
+// It works for any type gob supports but repeats the type information for every value,
+// prefer a dedicated Codec for large collections.
+func Gob[T any]() Codec[T] {
+	return MakeCodec(func(buf []byte, val T) ([]byte, error) {
+		var encoded bytes.Buffer
+		if err := gob.NewEncoder(&encoded).Encode(&val); err != nil {
+			return nil, err
+		}
+		buf = binary.AppendUvarint(buf, uint64(encoded.Len()))
+		return append(buf, encoded.Bytes()...), nil
+	}, func(data []byte) (T, int, error) {
+		var val T
+		b, n, err := decodeBytes(data)
+		if err != nil {
+			return val, 0, err
+		}
+		if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&val); err != nil {
+			return val, 0, err
+		}
+		return val, n, nil
+	})
+}
+
+// Default returns the built-in Codec for strings, byte slices and the numeric and boolean types,
+// and Gob for any other type
+func Default[T any]() Codec[T] {
+	var zero T
+	var c any
+	switch any(zero).(type) {
+	case string:
+		c = String[string]()
+	case []byte:
+		c = Bytes()
+	case int:
+		c = Varint[int]()
+	case int8:
+		c = Varint[int8]()
+	case int16:
+		c = Varint[int16]()
+	case int32:
+		c = Varint[int32]()
+	case int64:
+		c = Varint[int64]()
+	case uint:
+		c = Uvarint[uint]()
+	case uint8:
+		c = Uvarint[uint8]()
+	case uint16:
+		c = Uvarint[uint16]()
+	case uint32:
+		c = Uvarint[uint32]()
+	case uint64:
+		c = Uvarint[uint64]()
+	case uintptr:
+		c = Uvarint[uintptr]()
+	case float32:
+		c = Float32[float32]()
+	case float64:
+		c = Float64[float64]()
+	case complex64:
+		c = Complex64[complex64]()
+	case complex128:
+		c = Complex128[complex128]()
+	case bool:
+		c = Bool[bool]()
+	default:
+		return Gob[T]()
+	}
+	return c.(Codec[T])
+}
@@ -1,9 +1,15 @@
 package collections
 
-type Collection interface {
+// ReadOnlyCollection is the subset of Collection that never modifies the underlying data,
+// satisfied by immutable collections as well
+type ReadOnlyCollection interface {
 	IsEmpty() bool
 	IsNotEmpty() bool
 	Size() int
-	Clear()
 	Formatted() string
 }
+
+type Collection interface {
+	ReadOnlyCollection
+	Clear()
+}
@@ -0,0 +1,57 @@
+// Package collectionstest holds the contract every implementation of the collection interfaces must honour,
+// as reusable test suites:
+//
+//	func TestHashMap(t *testing.T) {
+//		collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+//			return dict.MakeHashMap[int, string](types.IntHash)
+//		})
+//	}
+//
+// Each suite runs table-driven checks of the semantics and edge cases of the interface, followed by a long sequence
+// of random operations compared step by step against a model built on Go's builtin maps and slices. Collections that
+// implement collections.Validator are validated after every operation of the sequence.
+package collectionstest
+
+import (
+	"math/rand"
+	"testing"
+	"utils-generics/collections"
+)
+
+const (
+	// randomOperations is the number of operations of the model based runs
+	randomOperations = 2000
+	// randomValues bounds the values of the model based runs, low enough for them to collide often
+	randomValues = 64
+)
+
+// newRand returns the source of the model based runs, seeded so that a failing run can be replayed
+func newRand() *rand.Rand {
+	return rand.New(rand.NewSource(1))
+}
+
+// checkSize checks that Size, IsEmpty and IsNotEmpty agree with the expected size
+func checkSize(t *testing.T, c collections.ReadOnlyCollection, expected int) bool {
+	t.Helper()
+	if c.Size() != expected {
+		t.Errorf("Size() = %d, expected %d", c.Size(), expected)
+		return false
+	}
+	if c.IsEmpty() != (expected == 0) || c.IsNotEmpty() != (expected != 0) {
+		t.Errorf("IsEmpty() = %t and IsNotEmpty() = %t for a size of %d", c.IsEmpty(), c.IsNotEmpty(), expected)
+		return false
+	}
+	return true
+}
+
+// checkValid validates the collection if it implements collections.Validator
+func checkValid(t *testing.T, c any) bool {
+	t.Helper()
+	if v, ok := c.(collections.Validator); ok {
+		if err := v.Validate(); err != nil {
+			t.Errorf("Validate() = %v", err)
+			return false
+		}
+	}
+	return true
+}
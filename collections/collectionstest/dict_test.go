@@ -0,0 +1,80 @@
+package collectionstest_test
+
+import (
+	"testing"
+	"utils-generics/collections/collectionstest"
+	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
+)
+
+func TestHashMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeHashMap[int, string](types.IntHash)
+	})
+}
+
+func TestComparableHashMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeComparableHashMap[int, string](types.IntHash)
+	})
+}
+
+func TestDefaultHashMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeDefaultHashMap[int, string]()
+	})
+}
+
+func TestHashMap_CollidingKeys(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeComparableHashMap[int, string](func(key int) int { return key % 3 })
+	})
+}
+
+func TestFlatHashMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeComparableFlatHashMap[int, string](types.IntHash)
+	})
+}
+
+func TestDefaultFlatHashMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeDefaultFlatHashMap[int, string]()
+	})
+}
+
+func TestFlatHashMap_CollidingKeys(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeComparableFlatHashMap[int, string](func(key int) int { return key % 3 })
+	})
+}
+
+func TestBinaryTreeMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeBinaryTreeMap[int, string](types.IntComparator)
+	})
+}
+
+func TestFlatMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeFlatMap[int, string](types.IntComparator)
+	})
+}
+
+func TestSkipListMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeSkipListMap[int, string](types.IntComparator)
+	})
+}
+
+func TestHashBiMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	})
+}
+
+func TestTreeBiMap(t *testing.T) {
+	collectionstest.TestMapContract(t, func() dict.Map[int, string] {
+		return dict.MakeTreeBiMap[int, string](types.IntComparator, types.StringComparator)
+	})
+}
@@ -0,0 +1,321 @@
+package collectionstest
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"utils-generics/collections/list"
+)
+
+// TestListContract checks that the lists returned by makeList behave like a list.List.
+// makeList must return a new, empty, list on every call.
+func TestListContract(t *testing.T, makeList func() list.List[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		l := makeList()
+
+		checkSize(t, l, 0)
+		_, ok := l.Get(0)
+		assert.False(t, ok)
+		assert.False(t, l.Contains(1))
+		assert.False(t, l.Remove(1))
+		assert.Empty(t, listValues(l))
+		assert.NotPanics(t, func() { _ = l.Formatted() })
+	})
+
+	t.Run("AddKeepsOrder", func(t *testing.T) {
+		l := makeList()
+		for _, val := range []int{3, 1, 2} {
+			l.Add(val)
+		}
+
+		checkSize(t, l, 3)
+		assert.Equal(t, []int{3, 1, 2}, listValues(l))
+		for i, expected := range []int{3, 1, 2} {
+			val, ok := l.Get(i)
+			assert.True(t, ok)
+			assert.Equal(t, expected, val)
+		}
+	})
+
+	t.Run("GetOutOfBounds", func(t *testing.T) {
+		l := makeList()
+		l.Add(1)
+		l.Add(2)
+
+		for _, index := range []int{-1, 2, 100} {
+			_, ok := l.Get(index)
+			assert.False(t, ok, "Get(%d)", index)
+		}
+	})
+
+	t.Run("AllowsDuplicates", func(t *testing.T) {
+		l := makeList()
+		l.Add(1)
+		l.Add(1)
+
+		checkSize(t, l, 2)
+		assert.True(t, l.Remove(1))
+		assert.True(t, l.Contains(1))
+		checkSize(t, l, 1)
+	})
+
+	t.Run("RemoveFirstOccurrence", func(t *testing.T) {
+		l := makeList()
+		for _, val := range []int{1, 2, 1, 3} {
+			l.Add(val)
+		}
+
+		assert.True(t, l.Remove(1))
+		assert.Equal(t, []int{2, 1, 3}, listValues(l))
+		assert.True(t, l.Remove(3))
+		assert.Equal(t, []int{2, 1}, listValues(l))
+		assert.False(t, l.Remove(3))
+		l.Add(4)
+		assert.Equal(t, []int{2, 1, 4}, listValues(l))
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		l := makeList()
+		l.Add(1)
+		l.Clear()
+
+		checkSize(t, l, 0)
+		l.Add(2)
+		assert.Equal(t, []int{2}, listValues(l))
+	})
+
+	t.Run("Formatted", func(t *testing.T) {
+		l := makeList()
+		l.Add(42)
+
+		formatted := l.Formatted()
+		assert.True(t, strings.Contains(formatted, "42"), "Formatted() = %q does not show the value", formatted)
+	})
+
+	t.Run("MatchesBuiltinSlice", func(t *testing.T) {
+		testListModel(t, makeList())
+	})
+}
+
+func testListModel(t *testing.T, l list.List[int]) {
+	r := newRand()
+	var model []int
+	for i := 0; i < randomOperations; i++ {
+		val := r.Intn(randomValues)
+		switch op := r.Intn(10); {
+		case op < 5:
+			l.Add(val)
+			model = append(model, val)
+		case op < 8:
+			index := indexOf(model, val)
+			if removed := l.Remove(val); removed != (index >= 0) {
+				t.Fatalf("operation %d: Remove(%d) = %t, expected %t", i, val, removed, index >= 0)
+			}
+			if index >= 0 {
+				model = append(model[:index], model[index+1:]...)
+			}
+		case op < 9:
+			index := r.Intn(len(model) + 1)
+			val, ok := l.Get(index)
+			if ok != (index < len(model)) || (ok && val != model[index]) {
+				t.Fatalf("operation %d: Get(%d) = %d, %t for %v", i, index, val, ok, model)
+			}
+		default:
+			if r.Intn(20) == 0 {
+				l.Clear()
+				model = nil
+			}
+		}
+
+		if !checkSize(t, l, len(model)) || !checkValid(t, l) {
+			t.Fatalf("operation %d broke the list", i)
+		}
+	}
+
+	assert.Equal(t, append([]int{}, model...), listValues(l))
+}
+
+// listValues returns the values of the list in order, read through Get
+func listValues(l list.List[int]) []int {
+	values := []int{}
+	for i := 0; i < l.Size(); i++ {
+		val, _ := l.Get(i)
+		values = append(values, val)
+	}
+	return values
+}
+
+func indexOf(values []int, val int) int {
+	for i, v := range values {
+		if v == val {
+			return i
+		}
+	}
+	return -1
+}
+
+// TestQueueContract checks that the queues returned by makeQueue behave like a FIFO list.Queue.
+// makeQueue must return a new, empty, queue on every call.
+func TestQueueContract(t *testing.T, makeQueue func() list.Queue[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		q := makeQueue()
+
+		checkSize(t, q, 0)
+		val, ok := q.Dequeue()
+		assert.False(t, ok)
+		assert.Zero(t, val)
+		val, ok = q.Peek()
+		assert.False(t, ok)
+		assert.Zero(t, val)
+		assert.NotPanics(t, func() { _ = q.Formatted() })
+	})
+
+	t.Run("FirstInFirstOut", func(t *testing.T) {
+		q := makeQueue()
+		for i := 1; i <= 3; i++ {
+			q.Enqueue(i)
+		}
+
+		for i := 1; i <= 3; i++ {
+			val, ok := q.Peek()
+			assert.True(t, ok)
+			assert.Equal(t, i, val)
+			val, ok = q.Dequeue()
+			assert.True(t, ok)
+			assert.Equal(t, i, val)
+		}
+		checkSize(t, q, 0)
+	})
+
+	t.Run("PeekDoesNotRemove", func(t *testing.T) {
+		q := makeQueue()
+		q.Enqueue(1)
+		q.Peek()
+
+		checkSize(t, q, 1)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		q := makeQueue()
+		q.Enqueue(1)
+		q.Clear()
+
+		checkSize(t, q, 0)
+		q.Enqueue(2)
+		val, _ := q.Dequeue()
+		assert.Equal(t, 2, val)
+	})
+
+	t.Run("MatchesBuiltinSlice", func(t *testing.T) {
+		r := newRand()
+		q := makeQueue()
+		var model []int
+		for i := 0; i < randomOperations; i++ {
+			switch op := r.Intn(10); {
+			case op < 5:
+				q.Enqueue(i)
+				model = append(model, i)
+			case op < 8:
+				val, ok := q.Dequeue()
+				if ok != (len(model) > 0) || (ok && val != model[0]) {
+					t.Fatalf("operation %d: Dequeue() = %d, %t for %v", i, val, ok, model)
+				}
+				if ok {
+					model = model[1:]
+				}
+			default:
+				val, ok := q.Peek()
+				if ok != (len(model) > 0) || (ok && val != model[0]) {
+					t.Fatalf("operation %d: Peek() = %d, %t for %v", i, val, ok, model)
+				}
+			}
+
+			if !checkSize(t, q, len(model)) || !checkValid(t, q) {
+				t.Fatalf("operation %d broke the queue", i)
+			}
+		}
+	})
+}
+
+// TestStackContract checks that the stacks returned by makeStack behave like a LIFO list.Stack.
+// makeStack must return a new, empty, stack on every call.
+func TestStackContract(t *testing.T, makeStack func() list.Stack[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		s := makeStack()
+
+		checkSize(t, s, 0)
+		val, ok := s.Pop()
+		assert.False(t, ok)
+		assert.Zero(t, val)
+		val, ok = s.Peek()
+		assert.False(t, ok)
+		assert.Zero(t, val)
+		assert.NotPanics(t, func() { _ = s.Formatted() })
+	})
+
+	t.Run("LastInFirstOut", func(t *testing.T) {
+		s := makeStack()
+		for i := 1; i <= 3; i++ {
+			s.Push(i)
+		}
+
+		for i := 3; i >= 1; i-- {
+			val, ok := s.Peek()
+			assert.True(t, ok)
+			assert.Equal(t, i, val)
+			val, ok = s.Pop()
+			assert.True(t, ok)
+			assert.Equal(t, i, val)
+		}
+		checkSize(t, s, 0)
+	})
+
+	t.Run("PeekDoesNotRemove", func(t *testing.T) {
+		s := makeStack()
+		s.Push(1)
+		s.Peek()
+
+		checkSize(t, s, 1)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		s := makeStack()
+		s.Push(1)
+		s.Clear()
+
+		checkSize(t, s, 0)
+		s.Push(2)
+		val, _ := s.Pop()
+		assert.Equal(t, 2, val)
+	})
+
+	t.Run("MatchesBuiltinSlice", func(t *testing.T) {
+		r := newRand()
+		s := makeStack()
+		var model []int
+		for i := 0; i < randomOperations; i++ {
+			switch op := r.Intn(10); {
+			case op < 5:
+				s.Push(i)
+				model = append(model, i)
+			case op < 8:
+				val, ok := s.Pop()
+				if ok != (len(model) > 0) || (ok && val != model[len(model)-1]) {
+					t.Fatalf("operation %d: Pop() = %d, %t for %v", i, val, ok, model)
+				}
+				if ok {
+					model = model[:len(model)-1]
+				}
+			default:
+				val, ok := s.Peek()
+				if ok != (len(model) > 0) || (ok && val != model[len(model)-1]) {
+					t.Fatalf("operation %d: Peek() = %d, %t for %v", i, val, ok, model)
+				}
+			}
+
+			if !checkSize(t, s, len(model)) || !checkValid(t, s) {
+				t.Fatalf("operation %d broke the stack", i)
+			}
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package collectionstest_test
+
+import (
+	"testing"
+	"utils-generics/collections/collectionstest"
+	"utils-generics/collections/list"
+)
+
+func TestLinkedList(t *testing.T) {
+	collectionstest.TestListContract(t, func() list.List[int] {
+		return list.MakeLinkedList[int]()
+	})
+}
+
+func TestComparableLinkedList(t *testing.T) {
+	collectionstest.TestListContract(t, func() list.List[int] {
+		return list.MakeComparableLinkedList[int]()
+	})
+}
+
+func TestDoubleLinkedList(t *testing.T) {
+	collectionstest.TestListContract(t, func() list.List[int] {
+		return list.MakeDoubleLinkedList[int]()
+	})
+}
+
+func TestComparableDoubleLinkedList(t *testing.T) {
+	collectionstest.TestListContract(t, func() list.List[int] {
+		return list.MakeComparableDoubleLinkedList[int]()
+	})
+}
+
+func TestSimpleQueue(t *testing.T) {
+	collectionstest.TestQueueContract(t, func() list.Queue[int] {
+		return list.MakeSimpleQueue[int]()
+	})
+}
+
+func TestSimpleStack(t *testing.T) {
+	collectionstest.TestStackContract(t, func() list.Stack[int] {
+		return list.MakeSimpleStack[int]()
+	})
+}
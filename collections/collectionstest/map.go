@@ -0,0 +1,162 @@
+package collectionstest
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"strings"
+	"testing"
+	"utils-generics/collections/dict"
+)
+
+// TestMapContract checks that the maps returned by makeMap behave like a dict.Map.
+// makeMap must return a new, empty, map on every call.
+//
+// The values put in the maps are never bound to two keys at once, so bidirectional maps can be tested as well.
+func TestMapContract(t *testing.T, makeMap func() dict.Map[int, string]) {
+	t.Run("Empty", func(t *testing.T) {
+		m := makeMap()
+
+		checkSize(t, m, 0)
+		_, ok := m.Get(1)
+		assert.False(t, ok)
+		assert.False(t, m.ContainsKey(1))
+		assert.False(t, m.Remove(1))
+		assert.Empty(t, m.Entries())
+		assert.Empty(t, m.Keys())
+		assert.Empty(t, m.Values())
+		assert.NotPanics(t, func() { _ = m.Formatted() })
+	})
+
+	t.Run("PutGet", func(t *testing.T) {
+		m := makeMap()
+		m.Put(1, "one")
+		m.Put(2, "two")
+
+		checkSize(t, m, 2)
+		val, ok := m.Get(1)
+		assert.True(t, ok)
+		assert.Equal(t, "one", val)
+		assert.True(t, m.ContainsKey(2))
+		assert.False(t, m.ContainsKey(3))
+	})
+
+	t.Run("PutOverwrites", func(t *testing.T) {
+		m := makeMap()
+		m.Put(1, "one")
+		m.Put(1, "uno")
+
+		checkSize(t, m, 1)
+		val, _ := m.Get(1)
+		assert.Equal(t, "uno", val)
+		assert.Equal(t, []string{"uno"}, m.Values())
+	})
+
+	t.Run("ZeroKey", func(t *testing.T) {
+		m := makeMap()
+		m.Put(0, "zero")
+		m.Put(-1, "minus one")
+
+		val, ok := m.Get(0)
+		assert.True(t, ok)
+		assert.Equal(t, "zero", val)
+		assert.True(t, m.Remove(-1))
+		checkSize(t, m, 1)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		m := makeMap()
+		m.Put(1, "one")
+		m.Put(2, "two")
+
+		assert.True(t, m.Remove(1))
+		assert.False(t, m.Remove(1))
+		assert.False(t, m.ContainsKey(1))
+		assert.Equal(t, []int{2}, m.Keys())
+		checkSize(t, m, 1)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		m := makeMap()
+		m.Put(1, "one")
+		m.Clear()
+
+		checkSize(t, m, 0)
+		assert.False(t, m.ContainsKey(1))
+		m.Put(1, "one")
+		checkSize(t, m, 1)
+	})
+
+	t.Run("EntriesKeysAndValuesAgree", func(t *testing.T) {
+		m := makeMap()
+		for i := 0; i < 50; i++ {
+			m.Put(i, strconv.Itoa(i))
+		}
+
+		entries, keys, values := m.Entries(), m.Keys(), m.Values()
+		if assert.Len(t, entries, 50) && assert.Len(t, keys, 50) && assert.Len(t, values, 50) {
+			for i, entry := range entries {
+				assert.Equal(t, keys[i], entry.Key)
+				assert.Equal(t, values[i], entry.Val)
+				assert.Equal(t, strconv.Itoa(entry.Key), entry.Val)
+			}
+		}
+	})
+
+	t.Run("Formatted", func(t *testing.T) {
+		m := makeMap()
+		m.Put(42, "answer")
+
+		formatted := m.Formatted()
+		assert.True(t, strings.Contains(formatted, "42") && strings.Contains(formatted, "answer"),
+			"Formatted() = %q does not show the entry", formatted)
+	})
+
+	t.Run("MatchesBuiltinMap", func(t *testing.T) {
+		testMapModel(t, makeMap())
+	})
+}
+
+func testMapModel(t *testing.T, m dict.Map[int, string]) {
+	r := newRand()
+	model := map[int]string{}
+	for i := 0; i < randomOperations; i++ {
+		key := r.Intn(randomValues)
+		switch op := r.Intn(10); {
+		case op < 5:
+			// the operation index makes every value unique
+			val := strconv.Itoa(key) + "#" + strconv.Itoa(i)
+			m.Put(key, val)
+			model[key] = val
+		case op < 8:
+			_, expected := model[key]
+			if removed := m.Remove(key); removed != expected {
+				t.Fatalf("operation %d: Remove(%d) = %t, expected %t", i, key, removed, expected)
+			}
+			delete(model, key)
+		case op < 9:
+			expected, ok := model[key]
+			if val, found := m.Get(key); found != ok || val != expected {
+				t.Fatalf("operation %d: Get(%d) = %q, %t, expected %q, %t", i, key, val, found, expected, ok)
+			}
+		default:
+			if r.Intn(20) == 0 {
+				m.Clear()
+				model = map[int]string{}
+			}
+		}
+
+		if !checkSize(t, m, len(model)) || !checkValid(t, m) {
+			t.Fatalf("operation %d broke the map", i)
+		}
+	}
+
+	entries := map[int]string{}
+	for _, entry := range m.Entries() {
+		entries[entry.Key] = entry.Val
+	}
+	assert.Equal(t, model, entries)
+
+	for key := range model {
+		assert.True(t, m.ContainsKey(key), "ContainsKey(%d)", key)
+	}
+}
@@ -0,0 +1,119 @@
+package collectionstest
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"utils-generics/collections/set"
+)
+
+// TestSetContract checks that the sets returned by makeSet behave like a set.Set.
+// makeSet must return a new, empty, set on every call.
+func TestSetContract(t *testing.T, makeSet func() set.Set[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		s := makeSet()
+
+		checkSize(t, s, 0)
+		assert.False(t, s.Contains(1))
+		assert.False(t, s.Remove(1))
+		assert.NotPanics(t, func() { _ = s.Formatted() })
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		s := makeSet()
+		s.Add(1)
+		s.Add(2)
+
+		checkSize(t, s, 2)
+		assert.True(t, s.Contains(1))
+		assert.True(t, s.Contains(2))
+		assert.False(t, s.Contains(3))
+	})
+
+	t.Run("AddIsIdempotent", func(t *testing.T) {
+		s := makeSet()
+		s.Add(1)
+		s.Add(1)
+
+		checkSize(t, s, 1)
+	})
+
+	t.Run("ZeroValue", func(t *testing.T) {
+		s := makeSet()
+		s.Add(0)
+		s.Add(-1)
+
+		assert.True(t, s.Contains(0))
+		assert.True(t, s.Remove(-1))
+		checkSize(t, s, 1)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		s := makeSet()
+		s.Add(1)
+		s.Add(2)
+
+		assert.True(t, s.Remove(1))
+		assert.False(t, s.Remove(1))
+		assert.False(t, s.Contains(1))
+		checkSize(t, s, 1)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		s := makeSet()
+		s.Add(1)
+		s.Clear()
+
+		checkSize(t, s, 0)
+		assert.False(t, s.Contains(1))
+		s.Add(1)
+		checkSize(t, s, 1)
+	})
+
+	t.Run("Formatted", func(t *testing.T) {
+		s := makeSet()
+		s.Add(42)
+
+		formatted := s.Formatted()
+		assert.True(t, strings.Contains(formatted, "42"), "Formatted() = %q does not show the element", formatted)
+	})
+
+	t.Run("MatchesBuiltinMap", func(t *testing.T) {
+		testSetModel(t, makeSet())
+	})
+}
+
+func testSetModel(t *testing.T, s set.Set[int]) {
+	r := newRand()
+	model := map[int]bool{}
+	for i := 0; i < randomOperations; i++ {
+		val := r.Intn(randomValues)
+		switch op := r.Intn(10); {
+		case op < 5:
+			s.Add(val)
+			model[val] = true
+		case op < 8:
+			if removed := s.Remove(val); removed != model[val] {
+				t.Fatalf("operation %d: Remove(%d) = %t, expected %t", i, val, removed, model[val])
+			}
+			delete(model, val)
+		case op < 9:
+			if found := s.Contains(val); found != model[val] {
+				t.Fatalf("operation %d: Contains(%d) = %t, expected %t", i, val, found, model[val])
+			}
+		default:
+			if r.Intn(20) == 0 {
+				s.Clear()
+				model = map[int]bool{}
+			}
+		}
+
+		if !checkSize(t, s, len(model)) || !checkValid(t, s) {
+			t.Fatalf("operation %d broke the set", i)
+		}
+	}
+
+	for val := 0; val < randomValues; val++ {
+		assert.Equal(t, model[val], s.Contains(val), "Contains(%d)", val)
+	}
+}
@@ -0,0 +1,38 @@
+package collectionstest_test
+
+import (
+	"testing"
+	"utils-generics/collections/collectionstest"
+	"utils-generics/collections/set"
+	"utils-generics/collections/types"
+)
+
+func TestHashSet(t *testing.T) {
+	collectionstest.TestSetContract(t, func() set.Set[int] {
+		return set.MakeHashSet[int](types.IntHash)
+	})
+}
+
+func TestDefaultHashSet(t *testing.T) {
+	collectionstest.TestSetContract(t, func() set.Set[int] {
+		return set.MakeDefaultHashSet[int]()
+	})
+}
+
+func TestBinaryTreeSet(t *testing.T) {
+	collectionstest.TestSetContract(t, func() set.Set[int] {
+		return set.MakeBinaryTreeSet[int](types.IntComparator)
+	})
+}
+
+func TestFlatSet(t *testing.T) {
+	collectionstest.TestSetContract(t, func() set.Set[int] {
+		return set.MakeFlatSet[int](types.IntComparator)
+	})
+}
+
+func TestSkipListSet(t *testing.T) {
+	collectionstest.TestSetContract(t, func() set.Set[int] {
+		return set.MakeSkipListSet[int](types.IntComparator)
+	})
+}
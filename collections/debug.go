@@ -0,0 +1,11 @@
+//go:build collections_debug
+
+package collections
+
+// Debug is true when built with the collections_debug tag, in which case every collection that implements
+// Validator checks its invariants after each mutation and panics as soon as one is broken, e.g.
+//
+//	go test -tags collections_debug ./...
+//
+// Validation usually costs O(n) per mutation, so it is meant for tests and debugging sessions only.
+const Debug = true
@@ -0,0 +1,202 @@
+package dict
+
+import (
+	"fmt"
+	"utils-generics/collections"
+	"utils-generics/collections/types"
+)
+
+// BiMap is a bidirectional map -- besides the usual key to value mapping it keeps the value to key mapping,
+// enforcing the uniqueness of both its keys and its values.
+//
+// The reverse mapping is exposed through Inverse, which returns a live view backed by the same data.
+// It is not thread safe and should not be used for concurrent access
+// (see concurrent package for thread safe implementations).
+//
+// It's performance characteristics are those of the underlying maps, e.g. for the hash based BiMap:
+//
+// - Put: O(1)
+//
+// - Get: O(1)
+//
+// - Remove: O(1)
+type BiMap[K any, V any] struct {
+	forward   Map[K, V]
+	backward  Map[V, K]
+	keyEquals func(a, b K) bool
+	valEquals func(a, b V) bool
+	inverse   *BiMap[V, K]
+}
+
+// MakeHashBiMap creates a new BiMap backed by two HashMaps, one hashing the keys and another hashing the values
+func MakeHashBiMap[K any, V any](kh func(K) int, vh func(V) int) *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:   MakeHashMap[K, V](kh),
+		backward:  MakeHashMap[V, K](vh),
+		keyEquals: types.DeepEquals[K],
+		valEquals: types.DeepEquals[V],
+	}
+}
+
+// MakeTreeBiMap creates a new BiMap backed by two BinaryTreeMaps, one ordering the keys and another ordering the values
+func MakeTreeBiMap[K any, V any](kc func(a, b K) int, vc func(a, b V) int) *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:   MakeBinaryTreeMap[K, V](kc),
+		backward:  MakeBinaryTreeMap[V, K](vc),
+		keyEquals: func(a, b K) bool { return kc(a, b) == 0 },
+		valEquals: func(a, b V) bool { return vc(a, b) == 0 },
+	}
+}
+
+// Inverse returns the inverse view of the map, mapping each value back to its key.
+//
+// The view is live: changes made through it are visible on this map and vice versa.
+func (s *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if s.inverse == nil {
+		s.inverse = &BiMap[V, K]{
+			forward:   s.backward,
+			backward:  s.forward,
+			keyEquals: s.valEquals,
+			valEquals: s.keyEquals,
+			inverse:   s,
+		}
+	}
+	return s.inverse
+}
+
+// Put adds a new entry to the map. If the key already exists, its value is replaced.
+//
+// Since values must be unique, Put panics if the value is already bound to a different key.
+// Use ForcePut to evict the conflicting entry instead.
+func (s *BiMap[K, V]) Put(key K, val V) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if bound, ok := s.backward.Get(val); ok {
+		if s.keyEquals(bound, key) {
+			return
+		}
+		panic(fmt.Sprintf("dict: BiMap value %v is already bound to key %v", val, bound))
+	}
+
+	s.put(key, val)
+}
+
+// ForcePut adds a new entry to the map, silently removing any entry that already holds the value
+// as well as the previous value of the key
+func (s *BiMap[K, V]) ForcePut(key K, val V) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if bound, ok := s.backward.Get(val); ok {
+		s.forward.Remove(bound)
+		s.backward.Remove(val)
+	}
+
+	s.put(key, val)
+}
+
+func (s *BiMap[K, V]) put(key K, val V) {
+	if old, ok := s.forward.Get(key); ok {
+		s.backward.Remove(old)
+	}
+
+	s.forward.Put(key, val)
+	s.backward.Put(val, key)
+}
+
+// replace replaces the entries of the map, restoring the previous ones if two keys are bound to the same value
+func (s *BiMap[K, V]) replace(entries []Entry[K, V]) error {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	previous := s.Entries()
+	s.Clear()
+	for _, entry := range entries {
+		if bound, ok := s.backward.Get(entry.Val); ok && !s.keyEquals(bound, entry.Key) {
+			s.Clear()
+			for _, e := range previous {
+				s.put(e.Key, e.Val)
+			}
+			return fmt.Errorf("dict: BiMap value %v is bound to both key %v and key %v", entry.Val, bound, entry.Key)
+		}
+		s.put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// Remove removes the entry identified by the key, returning true if the entry was found and removed
+// and false if the entry was not found
+func (s *BiMap[K, V]) Remove(key K) bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	val, ok := s.forward.Get(key)
+	if !ok {
+		return false
+	}
+
+	s.forward.Remove(key)
+	s.backward.Remove(val)
+	return true
+}
+
+// Get returns the value associated with the provided key and true if the key was found and false if otherwise
+func (s *BiMap[K, V]) Get(key K) (V, bool) {
+	return s.forward.Get(key)
+}
+
+// ContainsKey returns true if the map contains an entry with the provided key and false if otherwise
+func (s *BiMap[K, V]) ContainsKey(key K) bool {
+	return s.forward.ContainsKey(key)
+}
+
+// ContainsValue returns true if the map contains an entry with the provided value and false if otherwise
+func (s *BiMap[K, V]) ContainsValue(val V) bool {
+	return s.backward.ContainsKey(val)
+}
+
+// Size returns the number of entries in the map
+func (s *BiMap[K, V]) Size() int {
+	return s.forward.Size()
+}
+
+// IsEmpty returns true if the map is empty and false if otherwise
+func (s *BiMap[K, V]) IsEmpty() bool {
+	return s.forward.IsEmpty()
+}
+
+// IsNotEmpty returns true if the map is not empty and false if otherwise
+func (s *BiMap[K, V]) IsNotEmpty() bool {
+	return s.forward.IsNotEmpty()
+}
+
+// Clear removes all entries from the map
+func (s *BiMap[K, V]) Clear() {
+	s.forward.Clear()
+	s.backward.Clear()
+}
+
+// Formatted returns a string representation of the map
+func (s *BiMap[K, V]) Formatted() string {
+	return s.String()
+}
+
+// Entries returns a slice of all entries in the map
+func (s *BiMap[K, V]) Entries() []Entry[K, V] {
+	return s.forward.Entries()
+}
+
+// Keys returns a slice of all keys in the map
+func (s *BiMap[K, V]) Keys() []K {
+	return s.forward.Keys()
+}
+
+// Values returns a slice of all values in the map
+func (s *BiMap[K, V]) Values() []V {
+	return s.forward.Values()
+}
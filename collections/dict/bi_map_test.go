@@ -0,0 +1,111 @@
+package dict
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestBiMap_Put(t *testing.T) {
+	var m Map[int, string] = MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	m.Put(1, "one")
+	m.Put(2, "two")
+	m.Put(3, "three")
+
+	assert.Equal(t, 3, m.Size())
+}
+
+func TestBiMap_PutOverwritesKey(t *testing.T) {
+	m := MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	m.Put(1, "one")
+	m.Put(1, "uno")
+
+	val, ok := m.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "uno", val)
+	assert.False(t, m.ContainsValue("one"))
+	assert.True(t, m.ContainsValue("uno"))
+}
+
+func TestBiMap_PutSameEntryIsNoOp(t *testing.T) {
+	m := MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	m.Put(1, "one")
+
+	assert.NotPanics(t, func() { m.Put(1, "one") })
+	assert.Equal(t, 1, m.Size())
+}
+
+func TestBiMap_PutDuplicateValuePanics(t *testing.T) {
+	m := MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	m.Put(1, "one")
+
+	assert.Panics(t, func() { m.Put(2, "one") })
+	assert.Equal(t, 1, m.Size())
+}
+
+func TestBiMap_ForcePut(t *testing.T) {
+	m := MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	m.Put(1, "one")
+	m.Put(2, "two")
+
+	m.ForcePut(2, "one")
+
+	assert.Equal(t, 1, m.Size())
+	assert.False(t, m.ContainsKey(1))
+	assert.False(t, m.ContainsValue("two"))
+
+	key, ok := m.Inverse().Get("one")
+	assert.True(t, ok)
+	assert.Equal(t, 2, key)
+}
+
+func TestBiMap_Remove(t *testing.T) {
+	m := MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	m.Put(1, "one")
+	m.Put(2, "two")
+
+	assert.True(t, m.Remove(1))
+	assert.False(t, m.Remove(3))
+	assert.Equal(t, 1, m.Size())
+	assert.False(t, m.ContainsValue("one"))
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	m := MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	m.Put(1, "one")
+
+	var inv Map[string, int] = m.Inverse()
+	key, ok := inv.Get("one")
+	assert.True(t, ok)
+	assert.Equal(t, 1, key)
+
+	assert.Same(t, m, m.Inverse().Inverse())
+}
+
+func TestBiMap_InverseIsLive(t *testing.T) {
+	m := MakeHashBiMap[int, string](types.IntHash, types.StringHash)
+	inv := m.Inverse()
+
+	inv.Put("two", 2)
+	val, ok := m.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, "two", val)
+
+	m.Remove(2)
+	assert.True(t, inv.IsEmpty())
+}
+
+func TestBiMap_TreeBacked(t *testing.T) {
+	m := MakeTreeBiMap[string, int](types.StringComparator, types.IntComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	assert.Equal(t, []string{"a", "b", "c"}, m.Keys())
+	assert.Equal(t, []string{"a", "b", "c"}, m.Inverse().Values())
+	assert.Panics(t, func() { m.Put("d", 1) })
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.True(t, m.Inverse().IsEmpty())
+}
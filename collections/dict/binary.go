@@ -0,0 +1,292 @@
+package dict
+
+import (
+	"fmt"
+	"utils-generics/collections"
+	"utils-generics/collections/codec"
+)
+
+// File: binary.go
+// Binary encoding of the maps, see the codec package for the format.
+//
+// MarshalBinary and UnmarshalBinary use the default codecs of the keys and values, which also makes the maps
+// work with encoding/gob; EncodeBinary and DecodeBinary take dedicated codecs. Like for JSON, maps must be created
+// with their Make function before decoding into them, and decoding replaces their contents.
+
+// EncodeEntries encodes entries in the Entries layout, keeping their order
+func EncodeEntries[K any, T any](entries []Entry[K, T], kc codec.Codec[K], vc codec.Codec[T]) ([]byte, error) {
+	e := codec.NewEncoder(codec.Entries, len(entries))
+	for _, entry := range entries {
+		codec.Write(e, kc, entry.Key)
+		codec.Write(e, vc, entry.Val)
+	}
+	return e.Bytes()
+}
+
+// DecodeEntries decodes entries encoded by EncodeEntries, in the order they appear in the data
+func DecodeEntries[K any, T any](data []byte, kc codec.Codec[K], vc codec.Codec[T]) ([]Entry[K, T], error) {
+	d, n, err := codec.NewDecoder(data, codec.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry[K, T], 0, n)
+	for i := 0; i < n && d.Err() == nil; i++ {
+		key := codec.Read(d, kc)
+		val := codec.Read(d, vc)
+		entries = append(entries, Entry[K, T]{Key: key, Val: val})
+	}
+	if err := d.Finish(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// checkSorted returns an error unless the keys of the entries are strictly increasing
+func checkSorted[K any, T any](entries []Entry[K, T], comparator func(a, b K) int) error {
+	for i := 1; i < len(entries); i++ {
+		if comparator(entries[i-1].Key, entries[i].Key) >= 0 {
+			return fmt.Errorf("dict: keys %v and %v are not in strictly increasing order", entries[i-1].Key, entries[i].Key)
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes the map with the default codecs of its keys and values
+func (s *HashMap[K, T]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K](), codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the entries of the map with the ones decoded with the default codecs.
+// The map must have been created with one of the Make functions, as its hasher cannot be decoded.
+func (s *HashMap[K, T]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K](), codec.Default[T]())
+}
+
+// EncodeBinary encodes the map with the given codecs
+func (s *HashMap[K, T]) EncodeBinary(kc codec.Codec[K], vc codec.Codec[T]) ([]byte, error) {
+	return EncodeEntries(s.Entries(), kc, vc)
+}
+
+// DecodeBinary replaces the entries of the map with the ones decoded with the given codecs
+func (s *HashMap[K, T]) DecodeBinary(data []byte, kc codec.Codec[K], vc codec.Codec[T]) error {
+	if s.hasher == nil {
+		return errNotConstructed("HashMap")
+	}
+	entries, err := DecodeEntries(data, kc, vc)
+	if err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the map in key order with the default codecs of its keys and values
+func (s *BinaryTreeMap[K, T]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K](), codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the entries of the map with the ones decoded with the default codecs.
+// The map must have been created with MakeBinaryTreeMap, as its comparator cannot be decoded.
+func (s *BinaryTreeMap[K, T]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K](), codec.Default[T]())
+}
+
+// EncodeBinary encodes the map in key order with the given codecs
+func (s *BinaryTreeMap[K, T]) EncodeBinary(kc codec.Codec[K], vc codec.Codec[T]) ([]byte, error) {
+	return EncodeEntries(s.Entries(), kc, vc)
+}
+
+// DecodeBinary replaces the entries of the map with the ones decoded with the given codecs.
+// The entries are expected in key order, as written by EncodeBinary, which allows to rebuild the tree in O(n).
+func (s *BinaryTreeMap[K, T]) DecodeBinary(data []byte, kc codec.Codec[K], vc codec.Codec[T]) error {
+	if s.comparator == nil {
+		return errNotConstructed("BinaryTreeMap")
+	}
+	entries, err := DecodeEntries(data, kc, vc)
+	if err != nil {
+		return err
+	}
+	return s.LoadSorted(entries)
+}
+
+// LoadSorted replaces the entries of the map with the given ones, which must be sorted by strictly increasing keys.
+// The map is rebuilt as a balanced tree in O(n); it is left untouched if the entries are not sorted.
+func (s *BinaryTreeMap[K, T]) LoadSorted(entries []Entry[K, T]) error {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if err := checkSorted(entries, s.comparator); err != nil {
+		return err
+	}
+	s.root = buildBalanced(entries)
+	return nil
+}
+
+func buildBalanced[K any, T any](entries []Entry[K, T]) *binaryTreeNode[K, T] {
+	if len(entries) == 0 {
+		return nil
+	}
+	mid := len(entries) / 2
+	return &binaryTreeNode[K, T]{
+		Entry: entries[mid],
+		left:  buildBalanced(entries[:mid]),
+		right: buildBalanced(entries[mid+1:]),
+	}
+}
+
+// MarshalBinary encodes the map in key order with the default codecs of its keys and values
+func (s *FlatMap[K, T]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K](), codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the entries of the map with the ones decoded with the default codecs.
+// The map must have been created with MakeFlatMap, as its comparator cannot be decoded.
+func (s *FlatMap[K, T]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K](), codec.Default[T]())
+}
+
+// EncodeBinary encodes the map in key order with the given codecs
+func (s *FlatMap[K, T]) EncodeBinary(kc codec.Codec[K], vc codec.Codec[T]) ([]byte, error) {
+	return EncodeEntries(s.array, kc, vc)
+}
+
+// DecodeBinary replaces the entries of the map with the ones decoded with the given codecs.
+// The entries are expected in key order, as written by EncodeBinary, so they are loaded in O(n) without sorting.
+func (s *FlatMap[K, T]) DecodeBinary(data []byte, kc codec.Codec[K], vc codec.Codec[T]) error {
+	if s.comparator == nil {
+		return errNotConstructed("FlatMap")
+	}
+	entries, err := DecodeEntries(data, kc, vc)
+	if err != nil {
+		return err
+	}
+	return s.LoadSorted(entries)
+}
+
+// LoadSorted replaces the entries of the map with the given ones, which must be sorted by strictly increasing keys.
+// The entries are taken over as the backing array of the map in O(n); the map is left untouched if they are not sorted.
+func (s *FlatMap[K, T]) LoadSorted(entries []Entry[K, T]) error {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if err := checkSorted(entries, s.comparator); err != nil {
+		return err
+	}
+	s.array = entries
+	return nil
+}
+
+// MarshalBinary encodes the map with the default codecs of its keys and values
+func (s *FlatHashMap[K, T]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K](), codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the entries of the map with the ones decoded with the default codecs.
+// The map must have been created with one of the Make functions, as its hasher cannot be decoded.
+func (s *FlatHashMap[K, T]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K](), codec.Default[T]())
+}
+
+// EncodeBinary encodes the map with the given codecs
+func (s *FlatHashMap[K, T]) EncodeBinary(kc codec.Codec[K], vc codec.Codec[T]) ([]byte, error) {
+	return EncodeEntries(s.Entries(), kc, vc)
+}
+
+// DecodeBinary replaces the entries of the map with the ones decoded with the given codecs
+func (s *FlatHashMap[K, T]) DecodeBinary(data []byte, kc codec.Codec[K], vc codec.Codec[T]) error {
+	if s.hasher == nil {
+		return errNotConstructed("FlatHashMap")
+	}
+	entries, err := DecodeEntries(data, kc, vc)
+	if err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the map in key order with the default codecs of its keys and values
+func (s *SkipListMap[K, T]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K](), codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the entries of the map with the ones decoded with the default codecs.
+// The map must have been created with one of the Make functions, as its comparator cannot be decoded.
+func (s *SkipListMap[K, T]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K](), codec.Default[T]())
+}
+
+// EncodeBinary encodes the map in key order with the given codecs
+func (s *SkipListMap[K, T]) EncodeBinary(kc codec.Codec[K], vc codec.Codec[T]) ([]byte, error) {
+	return EncodeEntries(s.Entries(), kc, vc)
+}
+
+// DecodeBinary replaces the entries of the map with the ones decoded with the given codecs.
+// The entries are expected in key order, as written by EncodeBinary, which allows to rebuild the list in O(n).
+func (s *SkipListMap[K, T]) DecodeBinary(data []byte, kc codec.Codec[K], vc codec.Codec[T]) error {
+	if s.comparator == nil {
+		return errNotConstructed("SkipListMap")
+	}
+	entries, err := DecodeEntries(data, kc, vc)
+	if err != nil {
+		return err
+	}
+	return s.LoadSorted(entries)
+}
+
+// LoadSorted replaces the entries of the map with the given ones, which must be sorted by strictly increasing keys.
+// Each entry is appended after the previous one in O(1) expected; the map is left untouched if they are not sorted.
+func (s *SkipListMap[K, T]) LoadSorted(entries []Entry[K, T]) error {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if err := checkSorted(entries, s.comparator); err != nil {
+		return err
+	}
+	s.Clear()
+	for _, entry := range entries {
+		s.Put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the key to value mapping with the default codecs of the keys and values
+func (s *BiMap[K, V]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K](), codec.Default[V]())
+}
+
+// UnmarshalBinary replaces the entries of the map with the ones decoded with the default codecs.
+// It returns an error, leaving the map untouched, if two keys are bound to the same value.
+func (s *BiMap[K, V]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K](), codec.Default[V]())
+}
+
+// EncodeBinary encodes the key to value mapping with the given codecs
+func (s *BiMap[K, V]) EncodeBinary(kc codec.Codec[K], vc codec.Codec[V]) ([]byte, error) {
+	return EncodeEntries(s.Entries(), kc, vc)
+}
+
+// DecodeBinary replaces the entries of the map with the ones decoded with the given codecs.
+// It returns an error, leaving the map untouched, if two keys are bound to the same value.
+func (s *BiMap[K, V]) DecodeBinary(data []byte, kc codec.Codec[K], vc codec.Codec[V]) error {
+	if s.forward == nil {
+		return errNotConstructed("BiMap")
+	}
+	entries, err := DecodeEntries(data, kc, vc)
+	if err != nil {
+		return err
+	}
+	return s.replace(entries)
+}
@@ -0,0 +1,153 @@
+package dict
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/codec"
+	"utils-generics/collections/types"
+)
+
+func treeHeight[K any, T any](node *binaryTreeNode[K, T]) int {
+	if node == nil {
+		return 0
+	}
+	left, right := treeHeight(node.left), treeHeight(node.right)
+	if left > right {
+		return left + 1
+	}
+	return right + 1
+}
+
+func TestHashMap_Binary(t *testing.T) {
+	m := MakeHashMap[string, int](types.StringHash)
+	m.Put("one", 1)
+	m.Put("two", 2)
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeHashMap[string, int](types.StringHash)
+	decoded.Put("stale", 0)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.ElementsMatch(t, m.Entries(), decoded.Entries())
+
+	assert.Error(t, (&HashMap[string, int]{}).UnmarshalBinary(data))
+	assert.Error(t, decoded.UnmarshalBinary(data[:len(data)-1]))
+	assert.ElementsMatch(t, m.Entries(), decoded.Entries(), "a failed decoding leaves the map untouched")
+}
+
+func TestHashMap_Gob(t *testing.T) {
+	type cache struct {
+		Entries *HashMap[int, string]
+	}
+	m := MakeHashMap[int, string](types.IntHash)
+	m.Put(1, "one")
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(cache{Entries: m}))
+
+	decoded := cache{Entries: MakeHashMap[int, string](types.IntHash)}
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, m.Entries(), decoded.Entries.Entries())
+}
+
+func TestBinaryTreeMap_Binary_ReloadsBalanced(t *testing.T) {
+	m := MakeBinaryTreeMap[int, string](types.IntComparator)
+	for i := 0; i < 1000; i++ {
+		// sequential keys degenerate the unbalanced tree into a list
+		m.Put(i, "v")
+	}
+	assert.Equal(t, 1000, treeHeight(m.root))
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeBinaryTreeMap[int, string](types.IntComparator)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+	assert.Equal(t, 10, treeHeight(decoded.root))
+}
+
+func TestBinaryTreeMap_Binary_CustomCodecs(t *testing.T) {
+	m := MakeBinaryTreeMap[label, bool](func(a, b label) int { return types.StringComparator(string(a), string(b)) })
+	m.Put("b", true)
+	m.Put("a", false)
+
+	data, err := m.EncodeBinary(codec.String[label](), codec.Bool[bool]())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{codec.Version, byte(codec.Entries), 2, 1, 'a', 0, 1, 'b', 1}, data)
+
+	decoded := MakeBinaryTreeMap[label, bool](m.comparator)
+	assert.NoError(t, decoded.DecodeBinary(data, codec.String[label](), codec.Bool[bool]()))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+}
+
+func TestBinaryTreeMap_LoadSorted_RejectsUnsorted(t *testing.T) {
+	m := MakeBinaryTreeMap[int, int](types.IntComparator)
+	m.Put(1, 1)
+
+	err := m.LoadSorted([]Entry[int, int]{{2, 2}, {2, 3}})
+	assert.Error(t, err)
+	assert.Equal(t, []int{1}, m.Keys())
+
+	data, _ := EncodeEntries([]Entry[int, int]{{3, 3}, {1, 1}}, codec.Varint[int](), codec.Varint[int]())
+	assert.Error(t, m.UnmarshalBinary(data))
+}
+
+func TestFlatMap_Binary(t *testing.T) {
+	m := MakeFlatMap[string, float64](types.StringComparator)
+	m.Put("pi", 3.14)
+	m.Put("e", 2.71)
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeFlatMap[string, float64](types.StringComparator)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+	val, ok := decoded.Get("pi")
+	assert.True(t, ok)
+	assert.Equal(t, 3.14, val)
+
+	assert.Error(t, (&FlatMap[string, float64]{}).UnmarshalBinary(data))
+}
+
+func TestSkipListMap_Binary(t *testing.T) {
+	m := MakeSkipListMap[int, string](types.IntComparator)
+	for i := 0; i < 100; i++ {
+		m.Put(i*3, "v")
+	}
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeSkipListMap[int, string](types.IntComparator)
+	decoded.Put(-1, "stale")
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.NoError(t, decoded.Validate())
+	assert.Equal(t, m.Entries(), decoded.Entries())
+
+	assert.Error(t, decoded.LoadSorted([]Entry[int, string]{{2, "b"}, {1, "a"}}))
+	assert.Equal(t, 100, decoded.Size())
+	assert.Error(t, (&SkipListMap[int, string]{}).UnmarshalBinary(data))
+}
+
+func TestBiMap_Binary(t *testing.T) {
+	m := MakeHashBiMap[string, int](types.StringHash, types.IntHash)
+	m.Put("one", 1)
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeHashBiMap[string, int](types.StringHash, types.IntHash)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	key, ok := decoded.Inverse().Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "one", key)
+
+	conflicting, _ := EncodeEntries([]Entry[string, int]{{"a", 1}, {"b", 1}}, codec.Default[string](), codec.Default[int]())
+	assert.Error(t, decoded.UnmarshalBinary(conflicting))
+	assert.Equal(t, []string{"one"}, decoded.Keys())
+}
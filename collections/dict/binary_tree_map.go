@@ -1,8 +1,6 @@
 package dict
 
-import (
-	"fmt"
-)
+import "utils-generics/collections"
 
 type binaryTreeNode[K any, T any] struct {
 	Entry[K, T]
@@ -37,6 +35,10 @@ func MakeBinaryTreeMap[K any, T any](c func(a, b K) int) *BinaryTreeMap[K, T] {
 //
 // Time complexity: O(log n)
 func (s *BinaryTreeMap[K, T]) Put(key K, val T) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
 	if s.root == nil {
 		s.root = &binaryTreeNode[K, T]{Entry: Entry[K, T]{Key: key, Val: val}}
 		return
@@ -59,6 +61,7 @@ func (s *BinaryTreeMap[K, T]) Put(key K, val T) {
 			}
 			node = node.right
 		} else {
+			node.Val = val
 			return
 		}
 	}
@@ -68,6 +71,10 @@ func (s *BinaryTreeMap[K, T]) Put(key K, val T) {
 //
 // Time complexity: O(log n)
 func (s *BinaryTreeMap[K, T]) Remove(key K) bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
 	var parent *binaryTreeNode[K, T]
 	node := s.root
 
@@ -101,19 +108,23 @@ func removeNode[K any, T any](node *binaryTreeNode[K, T]) *binaryTreeNode[K, T]
 		return node.left
 	}
 
-	minNode := findMinNode(node.right)
-	node.Val = minNode.Val
-	node.right = removeNode(minNode)
+	// the node is replaced by its in-order successor i.e. the smallest entry of the right subtree
+	var successor *binaryTreeNode[K, T]
+	node.right, successor = removeMinNode(node.right)
+	node.Entry = successor.Entry
 
 	return node
 }
 
-func findMinNode[K any, T any](node *binaryTreeNode[K, T]) *binaryTreeNode[K, T] {
-	minNode := node
-	for minNode.left != nil {
-		minNode = minNode.left
+// removeMinNode detaches the smallest node of the subtree, returning the new subtree root and the detached node
+func removeMinNode[K any, T any](node *binaryTreeNode[K, T]) (*binaryTreeNode[K, T], *binaryTreeNode[K, T]) {
+	if node.left == nil {
+		return node.right, node
 	}
-	return minNode
+
+	var minNode *binaryTreeNode[K, T]
+	node.left, minNode = removeMinNode(node.left)
+	return node, minNode
 }
 
 // Get returns the entry from the map identified by the key along with a boolean value indicating if the key exists.
@@ -181,17 +192,9 @@ func (s *BinaryTreeMap[K, T]) Clear() {
 	s.root = nil
 }
 
-// Formatted returns a string representation of the set
+// Formatted returns a string representation of the map, in key order
 func (s *BinaryTreeMap[K, T]) Formatted() string {
-	return formatted(s.root)
-}
-
-func formatted[K any, T any](node *binaryTreeNode[K, T]) string {
-	if node == nil {
-		return ""
-	}
-
-	return fmt.Sprintf("(%s) - %T - (%s)", formatted(node.left), node.Key, formatted(node.right))
+	return s.String()
 }
 
 func (s *BinaryTreeMap[K, T]) Entries() []Entry[K, T] {
@@ -261,8 +264,16 @@ func (s *BinaryTreeMap[K, T]) Last() (K, T) {
 	return node.Key, node.Val
 }
 
-// RemoveFirst removes the first element of the set
+// RemoveFirst removes the first entry of the map, returning false if the map is empty
 func (s *BinaryTreeMap[K, T]) RemoveFirst() bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if s.root == nil {
+		return false
+	}
+
 	var parent *binaryTreeNode[K, T]
 	node := s.root
 
@@ -280,8 +291,16 @@ func (s *BinaryTreeMap[K, T]) RemoveFirst() bool {
 	return true
 }
 
-// RemoveLast removes the last element of the set
+// RemoveLast removes the last entry of the map, returning false if the map is empty
 func (s *BinaryTreeMap[K, T]) RemoveLast() bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if s.root == nil {
+		return false
+	}
+
 	var parent *binaryTreeNode[K, T]
 	node := s.root
 
@@ -299,35 +318,140 @@ func (s *BinaryTreeMap[K, T]) RemoveLast() bool {
 	return true
 }
 
+// ----------------
+// NavigableMap methods
+
+// Floor returns the entry with the greatest key less than or equal to the given key, if any
+//
+// Time complexity: O(log n)
+func (s *BinaryTreeMap[K, T]) Floor(key K) (Entry[K, T], bool) {
+	return s.closest(key, true, true)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the given key, if any
+//
+// Time complexity: O(log n)
+func (s *BinaryTreeMap[K, T]) Ceiling(key K) (Entry[K, T], bool) {
+	return s.closest(key, false, true)
+}
+
+// Lower returns the entry with the greatest key strictly less than the given key, if any
+//
+// Time complexity: O(log n)
+func (s *BinaryTreeMap[K, T]) Lower(key K) (Entry[K, T], bool) {
+	return s.closest(key, true, false)
+}
+
+// Higher returns the entry with the smallest key strictly greater than the given key, if any
+//
+// Time complexity: O(log n)
+func (s *BinaryTreeMap[K, T]) Higher(key K) (Entry[K, T], bool) {
+	return s.closest(key, false, false)
+}
+
+// closest walks down the tree keeping track of the best candidate seen so far on the requested side of the key
+func (s *BinaryTreeMap[K, T]) closest(key K, below bool, inclusive bool) (Entry[K, T], bool) {
+	var candidate *binaryTreeNode[K, T]
+	node := s.root
+	for node != nil {
+		c := s.comparator(key, node.Key)
+		if c == 0 && inclusive {
+			return node.Entry, true
+		}
+
+		if below {
+			if c > 0 {
+				candidate = node
+				node = node.right
+			} else {
+				node = node.left
+			}
+		} else {
+			if c < 0 {
+				candidate = node
+				node = node.left
+			} else {
+				node = node.right
+			}
+		}
+	}
+
+	if candidate == nil {
+		return Entry[K, T]{}, false
+	}
+	return candidate.Entry, true
+}
+
+// Range returns, in order, the entries whose keys are in the half-open interval [from, to)
+//
+// Time complexity: O(log n + m) where m is the number of entries returned
+func (s *BinaryTreeMap[K, T]) Range(from, to K) []Entry[K, T] {
+	var entries []Entry[K, T]
+	s.rangeFrom(s.root, from, to, &entries)
+	return entries
+}
+
+func (s *BinaryTreeMap[K, T]) rangeFrom(node *binaryTreeNode[K, T], from, to K, entries *[]Entry[K, T]) {
+	if node == nil {
+		return
+	}
+
+	afterFrom := s.comparator(node.Key, from) >= 0
+	beforeTo := s.comparator(node.Key, to) < 0
+	if afterFrom {
+		s.rangeFrom(node.left, from, to, entries)
+	}
+	if afterFrom && beforeTo {
+		*entries = append(*entries, node.Entry)
+	}
+	if beforeTo {
+		s.rangeFrom(node.right, from, to, entries)
+	}
+}
+
 // ----------------
 // Specialized methods
 
-// RightRotation performs a right rotation on the tree root node
+// RightRotation performs a right rotation on the tree root node, its left child becoming the new root.
+// It does nothing if the root has no left child.
 func (s *BinaryTreeMap[K, T]) RightRotation() {
-	rightRotationOnNode(s.root)
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	s.root = rightRotationOnNode(s.root)
 }
 
-// LeftRotation performs a left rotation on the tree root node
+// LeftRotation performs a left rotation on the tree root node, its right child becoming the new root.
+// It does nothing if the root has no right child.
 func (s *BinaryTreeMap[K, T]) LeftRotation() {
-	leftRotationOnNode(s.root)
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	s.root = leftRotationOnNode(s.root)
 }
 
-func rightRotationOnNode[K any, T any](node *binaryTreeNode[K, T]) {
-	if node == nil {
-		return
+// rightRotationOnNode rotates the subtree right and returns its new root
+func rightRotationOnNode[K any, T any](node *binaryTreeNode[K, T]) *binaryTreeNode[K, T] {
+	if node == nil || node.left == nil {
+		return node
 	}
 
 	leftTree := node.left
 	node.left = leftTree.right
 	leftTree.right = node
+	return leftTree
 }
 
-func leftRotationOnNode[K any, T any](node *binaryTreeNode[K, T]) {
-	if node == nil {
-		return
+// leftRotationOnNode rotates the subtree left and returns its new root
+func leftRotationOnNode[K any, T any](node *binaryTreeNode[K, T]) *binaryTreeNode[K, T] {
+	if node == nil || node.right == nil {
+		return node
 	}
 
 	rightTree := node.right
 	node.right = rightTree.left
 	rightTree.left = node
+	return rightTree
 }
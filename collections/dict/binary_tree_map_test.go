@@ -88,3 +88,71 @@ func TestBinaryTreeMap_Get(t *testing.T) {
 	assert.False(t, ok)
 	assert.Equal(t, "", val)
 }
+
+func TestBinaryTreeMap_PutOverwrites(t *testing.T) {
+	var m Map[string, string] = MakeBinaryTreeMap[string, string](types.StringComparator)
+	m.Put("1", "one")
+	m.Put("1", "uno")
+
+	val, ok := m.Get("1")
+	assert.True(t, ok)
+	assert.Equal(t, "uno", val)
+	assert.Equal(t, 1, m.Size())
+}
+
+func TestBinaryTreeMap_RemoveNodeWithTwoChildren(t *testing.T) {
+	var m Map[int, int] = MakeBinaryTreeMap[int, int](types.IntComparator)
+	for _, k := range []int{50, 30, 70, 20, 40, 60, 80, 65} {
+		m.Put(k, k*10)
+	}
+
+	assert.True(t, m.Remove(50))
+	assert.Equal(t, []int{20, 30, 40, 60, 65, 70, 80}, m.Keys())
+
+	val, ok := m.Get(65)
+	assert.True(t, ok)
+	assert.Equal(t, 650, val)
+}
+
+func TestBinaryTreeMap_Navigable(t *testing.T) {
+	var m NavigableMap[int, string] = MakeBinaryTreeMap[int, string](types.IntComparator)
+	m.Put(10, "ten")
+	m.Put(20, "twenty")
+	m.Put(30, "thirty")
+
+	entry, ok := m.Floor(15)
+	assert.True(t, ok)
+	assert.Equal(t, Entry[int, string]{Key: 10, Val: "ten"}, entry)
+
+	entry, ok = m.Ceiling(15)
+	assert.True(t, ok)
+	assert.Equal(t, 20, entry.Key)
+
+	entry, ok = m.Lower(10)
+	assert.False(t, ok)
+
+	entry, ok = m.Higher(10)
+	assert.True(t, ok)
+	assert.Equal(t, 20, entry.Key)
+
+	assert.Equal(t, []Entry[int, string]{{Key: 10, Val: "ten"}, {Key: 20, Val: "twenty"}}, m.Range(0, 30))
+}
+
+func TestBinaryTreeMap_StructKeys(t *testing.T) {
+	type version struct{ major, minor int }
+	c := types.Then(
+		types.By(func(v version) int { return v.major }, types.Ordered[int]),
+		types.By(func(v version) int { return v.minor }, types.Ordered[int]),
+	)
+
+	m := MakeBinaryTreeMap[version, string](c)
+	m.Put(version{1, 10}, "b")
+	m.Put(version{2, 0}, "c")
+	m.Put(version{1, 2}, "a")
+	m.Put(version{1, 2}, "a2")
+
+	assert.Equal(t, []version{{1, 2}, {1, 10}, {2, 0}}, m.Keys())
+	val, ok := m.Get(version{1, 2})
+	assert.True(t, ok)
+	assert.Equal(t, "a2", val)
+}
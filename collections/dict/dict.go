@@ -9,8 +9,8 @@ import "utils-generics/collections"
 // for all kinds of possible types
 // For out-of-the-box types, functions will be provided by the lib
 type Entry[K any, T any] struct {
-	Key K
-	Val T
+	Key K `json:"key"`
+	Val T `json:"val"`
 }
 
 type Map[K any, T any] interface {
@@ -32,3 +32,13 @@ type OrderedMap[K any, T any] interface {
 	RemoveFirst() bool
 	RemoveLast() bool
 }
+
+// NavigableMap is a sorted map that supports closest-match and range queries on its keys
+type NavigableMap[K any, T any] interface {
+	Map[K, T]
+	Floor(key K) (Entry[K, T], bool)
+	Ceiling(key K) (Entry[K, T], bool)
+	Lower(key K) (Entry[K, T], bool)
+	Higher(key K) (Entry[K, T], bool)
+	Range(from, to K) []Entry[K, T]
+}
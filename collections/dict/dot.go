@@ -0,0 +1,57 @@
+package dict
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: dot.go
+// Graphviz DOT export of the trees, see collections.DOTGraph.
+
+// ToDOT returns the shape of the tree as a Graphviz DOT digraph, every node labelled with its entry,
+// its height and its balance factor (the height of its left subtree minus the height of its right subtree)
+func (s *BinaryTreeMap[K, T]) ToDOT() string {
+	heights := map[*binaryTreeNode[K, T]]int{}
+	binaryTreeHeights(s.root, heights)
+
+	g := collections.MakeDOTGraph("BinaryTreeMap", "node [shape=box]")
+	collections.AddBinaryTreeDOT(g, s.root,
+		func(node *binaryTreeNode[K, T]) (*binaryTreeNode[K, T], *binaryTreeNode[K, T]) {
+			return node.left, node.right
+		},
+		func(node *binaryTreeNode[K, T]) (string, []string) {
+			balance := heights[node.left] - heights[node.right]
+			return fmt.Sprintf("%v: %v\nh=%d bf=%d", node.Key, node.Val, heights[node], balance), nil
+		})
+	return g.String()
+}
+
+// binaryTreeHeights stores the height of every node of the subtree, a leaf having height 1, and returns the height of node
+func binaryTreeHeights[K any, T any](node *binaryTreeNode[K, T], heights map[*binaryTreeNode[K, T]]int) int {
+	if node == nil {
+		return 0
+	}
+	h := binaryTreeHeights(node.left, heights)
+	if r := binaryTreeHeights(node.right, heights); r > h {
+		h = r
+	}
+	heights[node] = h + 1
+	return h + 1
+}
+
+// ToDOT returns the shape of the tree as a Graphviz DOT digraph, every node filled with its colour
+func (s *RedBlackTreeMap) ToDOT() string {
+	g := collections.MakeDOTGraph("RedBlackTreeMap", "node [shape=circle, style=filled, fontcolor=white]")
+	collections.AddBinaryTreeDOT(g, s.root,
+		func(node *redBlackTreeNode) (*redBlackTreeNode, *redBlackTreeNode) {
+			return node.left, node.right
+		},
+		func(node *redBlackTreeNode) (string, []string) {
+			colour := "fillcolor=black"
+			if node.red {
+				colour = "fillcolor=red"
+			}
+			return fmt.Sprintf("%d: %s", node.key, node.val), []string{colour}
+		})
+	return g.String()
+}
@@ -0,0 +1,66 @@
+package dict
+
+import (
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func assertGolden(t *testing.T, name string, actual string) {
+	golden := filepath.Join("testdata", name+".dot.golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(actual), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(expected), actual)
+}
+
+func TestBinaryTreeMap_ToDOT(t *testing.T) {
+	m := MakeBinaryTreeMap[int, string](types.IntComparator)
+	for _, key := range []int{4, 2, 6, 1, 3, 7} {
+		m.Put(key, strconv.Itoa(key*10))
+	}
+
+	assertGolden(t, "binary_tree_map", m.ToDOT())
+}
+
+func TestBinaryTreeMap_ToDOTAfterRotation(t *testing.T) {
+	m := MakeBinaryTreeMap[int, string](types.IntComparator)
+	for _, key := range []int{1, 2, 3} {
+		m.Put(key, strconv.Itoa(key))
+	}
+	m.LeftRotation()
+
+	assert.Equal(t, []int{1, 2, 3}, m.Keys())
+	assertGolden(t, "binary_tree_map_rotated", m.ToDOT())
+}
+
+func TestBinaryTreeMap_ToDOTEmpty(t *testing.T) {
+	m := MakeBinaryTreeMap[int, string](types.IntComparator)
+
+	assert.Equal(t, "digraph BinaryTreeMap {\n\tnode [shape=box];\n}\n", m.ToDOT())
+}
+
+func TestRedBlackTreeMap_ToDOT(t *testing.T) {
+	m := MakeRedBlackTreeMap()
+	m.Put(2, "two")
+	m.Put(1, "one")
+	m.Put(3, "three")
+
+	assertGolden(t, "red_black_tree_map", m.ToDOT())
+}
@@ -0,0 +1,277 @@
+package dict
+
+import (
+	"utils-generics/collections"
+	"utils-generics/collections/types"
+)
+
+// FlatHashMap is a hash map implementation using open addressing with Robin Hood probing.
+//
+// The entries are stored inline in a single array, with no node per entry as in HashMap, so that the map does not
+// allocate on Put unless it grows and gives the garbage collector a couple of pointers to scan, however many entries
+// it holds. Alongside every slot, a metadata array records how far the entry is from the slot its hash points to,
+// along with some bits of that hash, so that most probes are settled without comparing keys.
+//
+// Robin Hood probing lets an entry take the slot of one closer to its own home slot, which keeps the probe
+// sequences short and lets lookups of missing keys stop early. Removal shifts the following entries back instead
+// of leaving tombstones, so the map does not degrade after many removals.
+//
+// It makes no guarantees on ordering of its entries.
+//
+// It's performance characteristics are:
+//
+// - Put: O(1) amortized
+//
+// - Get: O(1)
+//
+// - Remove: O(1)
+type FlatHashMap[K any, T any] struct {
+	meta    []flatHashMeta
+	entries []Entry[K, T]
+	size    int
+	hasher  func(K) uint64
+	equals  func(a, b K) bool
+}
+
+// flatHashMeta is the metadata of a slot
+type flatHashMeta struct {
+	// dist is the distance from the home slot of the entry plus one, 0 for an empty slot
+	dist uint32
+	// hash holds the high bits of the hash of the entry
+	hash uint32
+}
+
+const (
+	flatHashMinCapacity = 8
+	// the map grows when it is more than 7/8 full
+	flatHashLoadNumerator   = 7
+	flatHashLoadDenominator = 8
+)
+
+// MakeFlatHashMap creates a new FlatHashMap that compares keys with reflect.DeepEqual.
+//
+// DeepEqual works for every key type but is slow, see MakeFlatHashMapWithEquals and MakeComparableFlatHashMap
+// for faster alternatives.
+func MakeFlatHashMap[K any, T any](h func(K) int) *FlatHashMap[K, T] {
+	return MakeFlatHashMapWithEquals[K, T](h, types.DeepEquals[K])
+}
+
+// MakeFlatHashMapWithEquals creates a new FlatHashMap that compares keys with the provided function,
+// which must agree with the hasher i.e. equal keys must have equal hashes
+func MakeFlatHashMapWithEquals[K any, T any](h func(K) int, equals func(a, b K) bool) *FlatHashMap[K, T] {
+	return &FlatHashMap[K, T]{hasher: func(key K) uint64 { return uint64(h(key)) }, equals: equals}
+}
+
+// MakeComparableFlatHashMap creates a new FlatHashMap for comparable keys, comparing them with ==
+func MakeComparableFlatHashMap[K comparable, T any](h func(K) int) *FlatHashMap[K, T] {
+	return MakeFlatHashMapWithEquals[K, T](h, types.Equals[K])
+}
+
+// MakeFlatHashMapWithHasher creates a new FlatHashMap that hashes and compares keys with the provided Hasher
+func MakeFlatHashMapWithHasher[K any, T any](h types.Hasher[K]) *FlatHashMap[K, T] {
+	return &FlatHashMap[K, T]{hasher: h.Hash, equals: h.Equal}
+}
+
+// MakeDefaultFlatHashMap creates a new FlatHashMap for string or integer keys, hashed with a randomly seeded hasher
+// so that keys colliding in one map do not collide in any other. See types.DefaultHasher.
+func MakeDefaultFlatHashMap[K comparable, T any]() *FlatHashMap[K, T] {
+	return MakeFlatHashMapWithHasher[K, T](types.DefaultHasher[K]())
+}
+
+// MakeHashableFlatHashMap creates a new FlatHashMap for keys that implement types.Hashable, delegating hashing
+// and comparison to the keys themselves
+func MakeHashableFlatHashMap[K types.Hashable[K], T any]() *FlatHashMap[K, T] {
+	return MakeFlatHashMapWithHasher[K, T](types.HashableHasher[K]())
+}
+
+// hash returns the hash of the key, mixed so that hashers whose low bits are poorly distributed, e.g. the
+// identity, still spread the keys over the slots
+func (s *FlatHashMap[K, T]) hash(key K) uint64 {
+	return types.Mix64(s.hasher(key))
+}
+
+// find returns the slot of the key, or -1 if the key is not in the map
+func (s *FlatHashMap[K, T]) find(key K) int {
+	if s.size == 0 {
+		return -1
+	}
+
+	hash := s.hash(key)
+	mask := uint64(len(s.meta) - 1)
+	i, dist, fragment := hash&mask, uint32(1), uint32(hash>>32)
+	for {
+		meta := s.meta[i]
+		// the entries of a probe sequence are sorted by distance, an entry closer to its home slot than the key
+		// would be means the key is not there; empty slots have a distance of 0 and end the search as well
+		if meta.dist < dist {
+			return -1
+		}
+		if meta.dist == dist && meta.hash == fragment && s.equals(s.entries[i].Key, key) {
+			return int(i)
+		}
+		i, dist = (i+1)&mask, dist+1
+	}
+}
+
+// Put adds a new entry to the map.
+//
+// If an entry with the key already exists the value is updated with the one provided
+func (s *FlatHashMap[K, T]) Put(key K, val T) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if i := s.find(key); i >= 0 {
+		s.entries[i].Val = val
+		return
+	}
+	if (s.size+1)*flatHashLoadDenominator > len(s.meta)*flatHashLoadNumerator {
+		s.grow()
+	}
+	s.insert(s.hash(key), Entry[K, T]{Key: key, Val: val})
+	s.size++
+}
+
+// insert places an entry whose key is not in the map, which has room for it
+func (s *FlatHashMap[K, T]) insert(hash uint64, entry Entry[K, T]) {
+	mask := uint64(len(s.meta) - 1)
+	i := hash & mask
+	meta := flatHashMeta{dist: 1, hash: uint32(hash >> 32)}
+	for {
+		if s.meta[i].dist == 0 {
+			s.meta[i], s.entries[i] = meta, entry
+			return
+		}
+		// take the slot of an entry closer to its home, which carries on looking for a slot in its place
+		if s.meta[i].dist < meta.dist {
+			s.meta[i], meta = meta, s.meta[i]
+			s.entries[i], entry = entry, s.entries[i]
+		}
+		i, meta.dist = (i+1)&mask, meta.dist+1
+	}
+}
+
+// grow doubles the number of slots, placing the entries again
+func (s *FlatHashMap[K, T]) grow() {
+	capacity := 2 * len(s.meta)
+	if capacity < flatHashMinCapacity {
+		capacity = flatHashMinCapacity
+	}
+
+	meta, entries := s.meta, s.entries
+	s.meta, s.entries = make([]flatHashMeta, capacity), make([]Entry[K, T], capacity)
+	for i, m := range meta {
+		if m.dist != 0 {
+			s.insert(s.hash(entries[i].Key), entries[i])
+		}
+	}
+}
+
+// Remove removes the entry identified by the key, returning true if the entry was found and removed
+// and false if the entry was not found
+func (s *FlatHashMap[K, T]) Remove(key K) bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	i := s.find(key)
+	if i < 0 {
+		return false
+	}
+
+	// shift back the entries that follow, up to an empty slot or an entry already in its home slot
+	mask := len(s.meta) - 1
+	for next := (i + 1) & mask; s.meta[next].dist > 1; i, next = next, (next+1)&mask {
+		s.meta[i], s.entries[i] = s.meta[next], s.entries[next]
+		s.meta[i].dist--
+	}
+	s.meta[i], s.entries[i] = flatHashMeta{}, Entry[K, T]{}
+	s.size--
+	return true
+}
+
+// ContainsKey returns true if the map contains an entry with the provided key and false if otherwise
+func (s *FlatHashMap[K, T]) ContainsKey(key K) bool {
+	return s.find(key) >= 0
+}
+
+// Get returns the value associated with the provided key and true if the key was found and false if otherwise
+func (s *FlatHashMap[K, T]) Get(key K) (T, bool) {
+	i := s.find(key)
+	if i < 0 {
+		var zero T
+		return zero, false
+	}
+	return s.entries[i].Val, true
+}
+
+// Size returns the number of entries in the map
+func (s *FlatHashMap[K, T]) Size() int {
+	return s.size
+}
+
+// IsEmpty returns true if the map is empty and false if otherwise
+func (s *FlatHashMap[K, T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// IsNotEmpty returns true if the map is not empty and false if otherwise
+func (s *FlatHashMap[K, T]) IsNotEmpty() bool {
+	return !s.IsEmpty()
+}
+
+// Formatted returns a string representation of the map
+func (s *FlatHashMap[K, T]) Formatted() string {
+	return s.String()
+}
+
+// Clear removes all entries from the map, keeping its slots for the entries to come
+func (s *FlatHashMap[K, T]) Clear() {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	for i := range s.meta {
+		s.meta[i], s.entries[i] = flatHashMeta{}, Entry[K, T]{}
+	}
+	s.size = 0
+}
+
+// forEach visits the entries until visit returns false
+func (s *FlatHashMap[K, T]) forEach(visit func(entry Entry[K, T]) bool) {
+	for i, meta := range s.meta {
+		if meta.dist != 0 && !visit(s.entries[i]) {
+			return
+		}
+	}
+}
+
+// Entries returns a slice of all entries in the map
+func (s *FlatHashMap[K, T]) Entries() []Entry[K, T] {
+	entries := make([]Entry[K, T], 0, s.size)
+	s.forEach(func(entry Entry[K, T]) bool {
+		entries = append(entries, entry)
+		return true
+	})
+	return entries
+}
+
+// Keys returns a slice of all keys in the map
+func (s *FlatHashMap[K, T]) Keys() []K {
+	keys := make([]K, 0, s.size)
+	s.forEach(func(entry Entry[K, T]) bool {
+		keys = append(keys, entry.Key)
+		return true
+	})
+	return keys
+}
+
+// Values returns a slice of all values in the map
+func (s *FlatHashMap[K, T]) Values() []T {
+	values := make([]T, 0, s.size)
+	s.forEach(func(entry Entry[K, T]) bool {
+		values = append(values, entry.Val)
+		return true
+	})
+	return values
+}
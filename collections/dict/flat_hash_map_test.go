@@ -0,0 +1,145 @@
+package dict
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestFlatHashMap_Put(t *testing.T) {
+	var m Map[string, string] = MakeComparableFlatHashMap[string, string](types.StringHash)
+	m.Put("1", "one")
+	m.Put("2", "two")
+	m.Put("1", "uno")
+
+	assert.Equal(t, 2, m.Size())
+	val, ok := m.Get("1")
+	assert.True(t, ok)
+	assert.Equal(t, "uno", val)
+	_, ok = m.Get("3")
+	assert.False(t, ok)
+}
+
+func TestFlatHashMap_Grow(t *testing.T) {
+	m := MakeComparableFlatHashMap[int, int](identityHash)
+	for i := 0; i < 1000; i++ {
+		m.Put(i, i*i)
+	}
+
+	assert.NoError(t, m.Validate())
+	assert.Equal(t, 1000, m.Size())
+	assert.Equal(t, 2048, len(m.meta))
+	for i := 0; i < 1000; i++ {
+		val, ok := m.Get(i)
+		assert.True(t, ok)
+		assert.Equal(t, i*i, val)
+	}
+	assert.False(t, m.ContainsKey(1000))
+}
+
+func TestFlatHashMap_Remove(t *testing.T) {
+	// all keys share a home slot, so every removal shifts the ones after it back
+	m := MakeComparableFlatHashMap[int, string](func(int) int { return 0 })
+	for i := 0; i < 6; i++ {
+		m.Put(i, "")
+	}
+
+	assert.True(t, m.Remove(2))
+	assert.False(t, m.Remove(2))
+	assert.True(t, m.Remove(0))
+	assert.NoError(t, m.Validate())
+	assert.ElementsMatch(t, []int{1, 3, 4, 5}, m.Keys())
+	for _, key := range []int{1, 3, 4, 5} {
+		assert.True(t, m.ContainsKey(key))
+	}
+}
+
+func TestFlatHashMap_RemoveWrapsAround(t *testing.T) {
+	m := MakeComparableFlatHashMap[int, int](identityHash)
+	m.Put(0, 0)
+	// find keys whose home is the last slot, so that their probe sequence wraps around to the first ones
+	last := uint64(len(m.meta) - 1)
+	var keys []int
+	for key := 1; len(keys) < 3; key++ {
+		if types.Mix64(uint64(key))&last == last {
+			keys = append(keys, key)
+			m.Put(key, key)
+		}
+	}
+	assert.NoError(t, m.Validate())
+
+	assert.True(t, m.Remove(keys[0]))
+	assert.NoError(t, m.Validate())
+	for _, key := range keys[1:] {
+		assert.True(t, m.ContainsKey(key))
+	}
+	assert.True(t, m.ContainsKey(0))
+}
+
+func TestFlatHashMap_Clear(t *testing.T) {
+	m := MakeDefaultFlatHashMap[int, int]()
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+	}
+	capacity := len(m.meta)
+
+	m.Clear()
+
+	assert.True(t, m.IsEmpty())
+	assert.Empty(t, m.Entries())
+	assert.Equal(t, capacity, len(m.meta))
+	m.Put(1, 1)
+	assert.True(t, m.IsNotEmpty())
+	assert.NoError(t, m.Validate())
+}
+
+func TestFlatHashMap_CustomEquals(t *testing.T) {
+	lower := func(s string) int { return types.StringHash(strings.ToLower(s)) }
+	var m Map[string, int] = MakeFlatHashMapWithEquals[string, int](lower, strings.EqualFold)
+	m.Put("Go", 1)
+	m.Put("GO", 2)
+
+	assert.Equal(t, 1, m.Size())
+	val, ok := m.Get("go")
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+	assert.True(t, m.Remove("gO"))
+}
+
+func TestFlatHashMap_DeepEquals(t *testing.T) {
+	m := MakeFlatHashMap[[]int, string](func(key []int) int { return len(key) })
+	m.Put([]int{1, 2}, "a")
+	m.Put([]int{1, 2}, "b")
+	m.Put([]int{2, 1}, "c")
+
+	assert.Equal(t, 2, m.Size())
+	val, _ := m.Get([]int{1, 2})
+	assert.Equal(t, "b", val)
+}
+
+func TestFlatHashMap_Hashable(t *testing.T) {
+	var m Map[point, string] = MakeHashableFlatHashMap[point, string]()
+	m.Put(point{1, 2}, "a")
+	m.Put(point{2, 1}, "b")
+	m.Put(point{1, 2}, "c")
+
+	assert.Equal(t, 2, m.Size())
+	val, ok := m.Get(point{1, 2})
+	assert.True(t, ok)
+	assert.Equal(t, "c", val)
+}
+
+func TestFlatHashMap_PutDoesNotAllocate(t *testing.T) {
+	m := MakeComparableFlatHashMap[int, int](identityHash)
+	for i := 0; i < 1000; i++ {
+		m.Put(i, i)
+	}
+	m.Remove(999)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		m.Put(999, 999)
+		m.Remove(999)
+	})
+	assert.Zero(t, allocs)
+}
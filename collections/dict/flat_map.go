@@ -1,5 +1,10 @@
 package dict
 
+import (
+	"sort"
+	"utils-generics/collections"
+)
+
 type FlatMap[K any, T any] struct {
 	array      []Entry[K, T]
 	comparator func(a, b K) int
@@ -9,18 +14,90 @@ func MakeFlatMap[K any, T any](c func(a, b K) int) *FlatMap[K, T] {
 	return &FlatMap[K, T]{comparator: c}
 }
 
+// MakeFlatMapFrom creates a new FlatMap holding the given entries, in any order.
+// They are sorted once in O(n log n), or merely checked in O(n) if they are sorted already, instead of being put
+// one by one in O(n²). When a key appears more than once the last of its entries wins, as it would with Put.
+// The entries are copied, not retained.
+func MakeFlatMapFrom[K any, T any](c func(a, b K) int, entries []Entry[K, T]) *FlatMap[K, T] {
+	s := MakeFlatMap[K, T](c)
+	s.array = sortEntries(entries, c)
+	if collections.Debug {
+		collections.MustBeValid(s)
+	}
+	return s
+}
+
+// sortEntries returns a copy of the entries sorted by key, keeping only the last entry of every key
+func sortEntries[K any, T any](entries []Entry[K, T], c func(a, b K) int) []Entry[K, T] {
+	sorted := make([]Entry[K, T], len(entries))
+	copy(sorted, entries)
+	if checkSorted(sorted, c) == nil {
+		return sorted
+	}
+
+	// the duplicates of a key are ordered by position so that the last one can be kept, which sort.Stable would do
+	// as well but much more slowly
+	positions := make([]int, len(sorted))
+	for i := range positions {
+		positions[i] = i
+	}
+	sort.Sort(entrySorter[K, T]{sorted, positions, c})
+
+	n := 0
+	for i, entry := range sorted {
+		if i > 0 && c(sorted[n-1].Key, entry.Key) == 0 {
+			sorted[n-1] = entry
+			continue
+		}
+		sorted[n] = entry
+		n++
+	}
+	return sorted[:n]
+}
+
+// entrySorter sorts entries by key, and then by their original position
+type entrySorter[K any, T any] struct {
+	entries    []Entry[K, T]
+	positions  []int
+	comparator func(a, b K) int
+}
+
+func (s entrySorter[K, T]) Len() int {
+	return len(s.entries)
+}
+
+func (s entrySorter[K, T]) Less(i, j int) bool {
+	c := s.comparator(s.entries[i].Key, s.entries[j].Key)
+	return c < 0 || (c == 0 && s.positions[i] < s.positions[j])
+}
+
+func (s entrySorter[K, T]) Swap(i, j int) {
+	s.entries[i], s.entries[j] = s.entries[j], s.entries[i]
+	s.positions[i], s.positions[j] = s.positions[j], s.positions[i]
+}
+
 /*
 * Binary search for the key in the array.
 * We keep the array sorted by key, so we can use binary search to find the key.
  */
 func (s *FlatMap[K, T]) binarySearch(key K) (Entry[K, T], bool) {
+	i, ok := s.index(key)
+	if !ok {
+		return Entry[K, T]{}, false
+	}
+	return s.array[i], true
+}
+
+// index returns the position of the key in the array and true if it is found,
+// or the position the key would be inserted at and false if otherwise
+func (s *FlatMap[K, T]) index(key K) (int, bool) {
 	l, h := 0, len(s.array)-1
 	for l <= h {
 		m := (l + h) / 2
 
 		c := s.comparator(s.array[m].Key, key)
 		if c == 0 {
-			return s.array[m], true
+			return m, true
 		}
 		if c < 0 {
 			l = m + 1
@@ -29,33 +106,101 @@ func (s *FlatMap[K, T]) binarySearch(key K) (Entry[K, T], bool) {
 		}
 	}
 
-	return Entry[K, T]{}, false
+	return l, false
 }
 
 // Put inserts a new key-value pair into the map. If the key already exists, the value is updated.
 func (s *FlatMap[K, T]) Put(key K, val T) {
-	s.array = append(s.array, Entry[K, T]{Key: key, Val: val})
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
 
-	for i := len(s.array) - 1; i > 0; i-- {
-		// conserve the well ordering of the array elements
-		c := s.comparator(s.array[i].Key, s.array[i-1].Key)
-		if c == -1 {
-			s.array[i], s.array[i-1] = s.array[i-1], s.array[i]
-		} else {
-			break
-		}
+	i, ok := s.index(key)
+	if ok {
+		s.array[i].Val = val
+		return
 	}
+
+	// conserve the well ordering of the array elements
+	s.array = append(s.array, Entry[K, T]{})
+	copy(s.array[i+1:], s.array[i:])
+	s.array[i] = Entry[K, T]{Key: key, Val: val}
 }
 
 func (s *FlatMap[K, T]) Remove(key K) bool {
-	for i, entry := range s.array {
-		if s.comparator(entry.Key, key) == 0 {
-			s.array = append(s.array[:i], s.array[i+1:]...)
-			return true
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	i, ok := s.index(key)
+	if !ok {
+		return false
+	}
+
+	copy(s.array[i:], s.array[i+1:])
+	// clear the last slot so that the removed key and value can be garbage collected
+	s.array[len(s.array)-1] = Entry[K, T]{}
+	s.array = s.array[:len(s.array)-1]
+	return true
+}
+
+// PutAll puts all the entries in the map, updating the values of the keys that already exist.
+//
+// The batch is merged with the map in O(n + m) for m entries sorted by strictly increasing keys, instead of the
+// O(n * m) of putting them one by one. A batch in any other order is sorted first, in O(m log m), and when a key
+// appears more than once in it the last entry wins.
+func (s *FlatMap[K, T]) PutAll(entries []Entry[K, T]) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if len(entries) == 0 {
+		return
+	}
+	batch := sortEntries(entries, s.comparator)
+
+	merged := make([]Entry[K, T], 0, len(s.array)+len(batch))
+	i, j := 0, 0
+	for i < len(s.array) && j < len(batch) {
+		switch c := s.comparator(s.array[i].Key, batch[j].Key); {
+		case c < 0:
+			merged = append(merged, s.array[i])
+			i++
+		case c > 0:
+			merged = append(merged, batch[j])
+			j++
+		default:
+			merged = append(merged, batch[j])
+			i++
+			j++
+		}
+	}
+	merged = append(merged, s.array[i:]...)
+	s.array = append(merged, batch[j:]...)
+}
+
+// RemoveIf removes all the entries that match the predicate in O(n), returning how many were removed
+func (s *FlatMap[K, T]) RemoveIf(predicate func(key K, val T) bool) int {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	n := 0
+	for _, entry := range s.array {
+		if !predicate(entry.Key, entry.Val) {
+			s.array[n] = entry
+			n++
 		}
 	}
 
-	return false
+	removed := len(s.array) - n
+	// clear the tail so that the removed keys and values can be garbage collected
+	var zero Entry[K, T]
+	for i := n; i < len(s.array); i++ {
+		s.array[i] = zero
+	}
+	s.array = s.array[:n]
+	return removed
 }
 
 func (s *FlatMap[K, T]) Get(key K) (T, bool) {
@@ -88,9 +233,9 @@ func (s *FlatMap[K, T]) Clear() {
 	s.array = []Entry[K, T]{}
 }
 
+// Formatted returns a string representation of the map, in key order
 func (s *FlatMap[K, T]) Formatted() string {
-	//TODO implement me
-	panic("implement me")
+	return s.String()
 }
 
 func (s *FlatMap[K, T]) Entries() []Entry[K, T] {
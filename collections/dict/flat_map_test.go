@@ -71,6 +71,20 @@ func TestFlatMap_Remove(t *testing.T) {
 	assert.Equal(t, 1, m.Size())
 }
 
+func TestFlatMap_RemoveClearsSlot(t *testing.T) {
+	m := MakeFlatMap[int, *string](types.IntComparator)
+	for i := 0; i < 3; i++ {
+		val := "value"
+		m.Put(i, &val)
+	}
+
+	assert.True(t, m.Remove(1))
+
+	// the slot past the end must not keep the last entry reachable
+	assert.Equal(t, []int{0, 2}, m.Keys())
+	assert.Equal(t, Entry[int, *string]{}, m.array[:3][2])
+}
+
 func TestFlatMap_RemoveNonExistent(t *testing.T) {
 	var m Map[string, string] = MakeFlatMap[string, string](types.StringComparator)
 	m.Put("1", "one")
@@ -104,3 +118,52 @@ func TestFlatMap_GetNonExistent(t *testing.T) {
 	assert.False(t, ok)
 	assert.Equal(t, "", val)
 }
+
+func TestMakeFlatMapFrom(t *testing.T) {
+	entries := []Entry[int, string]{{3, "three"}, {1, "one"}, {2, "two"}, {1, "uno"}}
+	m := MakeFlatMapFrom(types.IntComparator, entries)
+
+	assert.Equal(t, []Entry[int, string]{{1, "uno"}, {2, "two"}, {3, "three"}}, m.Entries())
+	// the entries are copied
+	assert.Equal(t, Entry[int, string]{3, "three"}, entries[0])
+	assert.NoError(t, m.Validate())
+}
+
+func TestMakeFlatMapFrom_Sorted(t *testing.T) {
+	entries := []Entry[int, string]{{1, "one"}, {2, "two"}}
+	m := MakeFlatMapFrom(types.IntComparator, entries)
+	m.Put(1, "uno")
+
+	assert.Equal(t, []Entry[int, string]{{1, "uno"}, {2, "two"}}, m.Entries())
+	assert.Equal(t, "one", entries[0].Val)
+}
+
+func TestFlatMap_PutAll(t *testing.T) {
+	m := MakeFlatMapFrom(types.IntComparator, []Entry[int, string]{{1, "one"}, {3, "three"}, {5, "five"}})
+	m.PutAll([]Entry[int, string]{{6, "six"}, {3, "tres"}, {0, "zero"}, {4, "four"}, {6, "seis"}})
+
+	assert.Equal(t, []Entry[int, string]{{0, "zero"}, {1, "one"}, {3, "tres"}, {4, "four"}, {5, "five"}, {6, "seis"}}, m.Entries())
+	assert.NoError(t, m.Validate())
+}
+
+func TestFlatMap_PutAll_Empty(t *testing.T) {
+	m := MakeFlatMap[int, string](types.IntComparator)
+	m.PutAll(nil)
+	assert.True(t, m.IsEmpty())
+
+	m.PutAll([]Entry[int, string]{{2, "two"}, {1, "one"}})
+	assert.Equal(t, []int{1, 2}, m.Keys())
+}
+
+func TestFlatMap_RemoveIf(t *testing.T) {
+	m := MakeFlatMap[int, string](types.IntComparator)
+	for i := 0; i < 10; i++ {
+		m.Put(i, "")
+	}
+
+	removed := m.RemoveIf(func(key int, _ string) bool { return key%3 == 0 })
+
+	assert.Equal(t, 4, removed)
+	assert.Equal(t, []int{1, 2, 4, 5, 7, 8}, m.Keys())
+	assert.Equal(t, 0, m.RemoveIf(func(int, string) bool { return false }))
+}
@@ -0,0 +1,156 @@
+package dict
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: format.go
+// Printing of the maps through fmt, see collections.Format for the supported verbs and flags.
+
+// String returns the entries of the map, as printed by the %v verb
+func (s *HashMap[K, T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter
+func (s *HashMap[K, T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: s.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			for _, node := range s.table {
+				for ; node != nil; node = node.next {
+					if !visit(node.Key, node.Val) {
+						return
+					}
+				}
+			}
+		},
+	})
+}
+
+// String returns the entries of the map in key order, as printed by the %v verb
+func (s *BinaryTreeMap[K, T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the entries in key order
+func (s *BinaryTreeMap[K, T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: s.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			// iterative in-order walk, so that printing a truncated map can stop early
+			var stack []*binaryTreeNode[K, T]
+			node := s.root
+			for node != nil || len(stack) > 0 {
+				for ; node != nil; node = node.left {
+					stack = append(stack, node)
+				}
+				node = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if !visit(node.Key, node.Val) {
+					return
+				}
+				node = node.right
+			}
+		},
+	})
+}
+
+// String returns the entries of the map in key order, as printed by the %v verb
+func (s *FlatMap[K, T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the entries in key order
+func (s *FlatMap[K, T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: len(s.array), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			for _, entry := range s.array {
+				if !visit(entry.Key, entry.Val) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the entries of the map, as printed by the %v verb
+func (s *BiMap[K, V]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter
+func (s *BiMap[K, V]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: s.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[V](),
+		Each: func(visit func(key, val any) bool) {
+			for _, entry := range s.forward.Entries() {
+				if !visit(entry.Key, entry.Val) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the entries of the map in key order, as printed by the %v verb
+func (s *FrozenSortedMap[K, T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the entries in key order
+func (s *FrozenSortedMap[K, T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: s.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			s.ForEach(func(key K, val T) bool { return visit(key, val) })
+		},
+	})
+}
+
+// String returns the entries of the map, as printed by the %v verb
+func (s *FrozenHashMap[K, T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter
+func (s *FrozenHashMap[K, T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: s.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			s.ForEach(func(key K, val T) bool { return visit(key, val) })
+		},
+	})
+}
+
+// String returns the entries of the map in key order, as printed by the %v verb
+func (s *SkipListMap[K, T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the entries in key order
+func (s *SkipListMap[K, T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: s.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			s.ForEach(func(key K, val T) bool { return visit(key, val) })
+		},
+	})
+}
+
+// String returns the entries of the map, as printed by the %v verb
+func (s *FlatHashMap[K, T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter
+func (s *FlatHashMap[K, T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: s.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			s.forEach(func(entry Entry[K, T]) bool { return visit(entry.Key, entry.Val) })
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package dict
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestHashMap_Format(t *testing.T) {
+	m := MakeDefaultHashMap[int, string]()
+	m.Put(1, "one")
+
+	assert.Equal(t, "{1: one}", m.String())
+	assert.Equal(t, m.String(), m.Formatted())
+	assert.Equal(t, `map[int]string{1: "one"}`, fmt.Sprintf("%#v", m))
+	assert.Equal(t, "{}", MakeDefaultHashMap[int, string]().String())
+}
+
+func TestBinaryTreeMap_Format(t *testing.T) {
+	m := MakeBinaryTreeMap[int, string](types.IntComparator)
+	m.Put(2, "b")
+	m.Put(1, "a")
+	m.Put(3, "c")
+
+	assert.Equal(t, "{1: a, 2: b, 3: c}", m.Formatted())
+	assert.Equal(t, "dict.BinaryTreeMap[int,string] size=3 {1: a, 2: b, 3: c}", fmt.Sprintf("%+v", m))
+	assert.Equal(t, "{1: a, ... 2 more}", fmt.Sprintf("%.1v", m))
+}
+
+func TestFlatMap_Format(t *testing.T) {
+	m := MakeFlatMap[string, string](types.StringComparator)
+	m.Put("b", "two")
+	m.Put("a", "one")
+
+	assert.Equal(t, "{a: one, b: two}", m.Formatted())
+	assert.Equal(t, `{"a": "one", "b": "two"}`, fmt.Sprintf("%q", m))
+	assert.Equal(t, "{}", MakeFlatMap[string, string](types.StringComparator).String())
+}
+
+func TestBiMap_Format(t *testing.T) {
+	m := MakeTreeBiMap[int, int](types.IntComparator, types.IntComparator)
+	m.Put(1, 10)
+	m.Put(2, 20)
+
+	assert.Equal(t, "{1: 10, 2: 20}", m.Formatted())
+	// the verb applies to the keys as well as the values
+	assert.Equal(t, "{01: 10, 02: 20}", fmt.Sprintf("%02d", m))
+}
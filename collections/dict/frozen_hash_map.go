@@ -0,0 +1,259 @@
+package dict
+
+import (
+	"fmt"
+	"math/bits"
+	"utils-generics/collections"
+	"utils-generics/collections/types"
+)
+
+// FrozenHashMap is a read-only hash map over a fixed set of keys, e.g. a routing table known at build time.
+//
+// It is built with a minimal perfect hash function, following the hash, displace and compress (CHD) algorithm:
+// the keys are spread over small buckets, and every bucket gets a displacement, found when the map is built,
+// that sends its keys to slots no other key uses. Each of the n keys ends up in its own slot of an array of
+// exactly n slots, so that a lookup hashes the key once and probes a single slot, with no chains or collisions.
+//
+// The key stored in the slot is compared with the one looked up, so keys that are not in the map are reported
+// as missing rather than being mapped to the slot of another key.
+//
+// It's performance characteristics are:
+//
+// - Get: O(1), a single probe
+//
+// - Construction: O(n) expected
+type FrozenHashMap[K any, T any] struct {
+	keys   []K
+	values []T
+	// displacements holds the displacement of every bucket: the slot of a key of a bucket holding a single key,
+	// encoded as -slot-1, or else the seed that rehashes the keys of the bucket to their slots
+	displacements []int32
+	hasher        types.Hasher[K]
+}
+
+const (
+	// frozenHashBucketSize is the average number of keys per bucket, larger buckets make for a smaller table
+	// of displacements but take longer to place
+	frozenHashBucketSize = 4
+	// frozenHashMaxSeed bounds the search for the seed of a bucket, which is expected to take a handful of tries
+	// and could only fail to end with a broken hasher
+	frozenHashMaxSeed = 1 << 24
+)
+
+// MakeFrozenHashMap creates a new FrozenHashMap holding the entries of the map, hashed and compared with the Hasher.
+//
+// It returns an error if the hasher gives the same hash to two distinct keys, as no hash function built on top
+// of it could tell them apart, or if the map holds keys that are equal according to the hasher.
+func MakeFrozenHashMap[K any, T any](h types.Hasher[K], m Map[K, T]) (*FrozenHashMap[K, T], error) {
+	entries := m.Entries()
+	n := len(entries)
+	s := &FrozenHashMap[K, T]{keys: make([]K, n), values: make([]T, n), hasher: h}
+	if n == 0 {
+		return s, nil
+	}
+
+	// hash the keys into their buckets, placing the largest buckets first while most of the slots are free
+	s.displacements = make([]int32, (n+frozenHashBucketSize-1)/frozenHashBucketSize)
+	buckets := make([][]int, len(s.displacements))
+	hashes := make([]uint64, n)
+	for i, entry := range entries {
+		hashes[i] = h.Hash(entry.Key)
+		b := s.bucket(hashes[i])
+		buckets[b] = append(buckets[b], i)
+	}
+	// a counting sort, as buckets are small
+	var bySize [][]int
+	for b, bucket := range buckets {
+		for len(bySize) <= len(bucket) {
+			bySize = append(bySize, nil)
+		}
+		bySize[len(bucket)] = append(bySize[len(bucket)], b)
+	}
+	var order []int
+	for size := len(bySize) - 1; size > 0; size-- {
+		order = append(order, bySize[size]...)
+	}
+
+	used := make([]bool, n)
+	slots := make([]int, 0, frozenHashBucketSize)
+	free := 0
+	for _, b := range order {
+		bucket := buckets[b]
+		if len(bucket) == 1 {
+			// a single key can go to any free slot, no need to look for a seed
+			for used[free] {
+				free++
+			}
+			used[free] = true
+			s.displacements[b] = int32(-free - 1)
+			s.place(free, entries[bucket[0]])
+			continue
+		}
+		if err := checkSeparable(h, entries, hashes, bucket); err != nil {
+			return nil, err
+		}
+
+		seed, ok := int32(0), false
+		for !ok {
+			seed++
+			if seed == frozenHashMaxSeed {
+				return nil, fmt.Errorf("dict: FrozenHashMap found no perfect hash for %d keys", n)
+			}
+			slots, ok = slots[:0], true
+			for _, i := range bucket {
+				slot := s.slot(hashes[i], seed)
+				if used[slot] || containsInt(slots, slot) {
+					ok = false
+					break
+				}
+				slots = append(slots, slot)
+			}
+		}
+
+		s.displacements[b] = seed
+		for k, i := range bucket {
+			used[slots[k]] = true
+			s.place(slots[k], entries[i])
+		}
+	}
+
+	if collections.Debug {
+		collections.MustBeValid(s)
+	}
+	return s, nil
+}
+
+// MakeDefaultFrozenHashMap creates a new FrozenHashMap for string or integer keys, hashed with a randomly seeded
+// hasher, holding the entries of the map. See types.DefaultHasher.
+func MakeDefaultFrozenHashMap[K comparable, T any](m Map[K, T]) (*FrozenHashMap[K, T], error) {
+	return MakeFrozenHashMap(types.DefaultHasher[K](), m)
+}
+
+// checkSeparable returns an error if two keys of the bucket have the same hash, in which case no seed sends them
+// to different slots
+func checkSeparable[K any, T any](h types.Hasher[K], entries []Entry[K, T], hashes []uint64, bucket []int) error {
+	for x, i := range bucket {
+		for _, j := range bucket[x+1:] {
+			if hashes[i] != hashes[j] {
+				continue
+			}
+			if h.Equal(entries[i].Key, entries[j].Key) {
+				return fmt.Errorf("dict: FrozenHashMap key %v is given twice", entries[i].Key)
+			}
+			return fmt.Errorf("dict: FrozenHashMap keys %v and %v have the same hash", entries[i].Key, entries[j].Key)
+		}
+	}
+	return nil
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *FrozenHashMap[K, T]) place(slot int, entry Entry[K, T]) {
+	s.keys[slot], s.values[slot] = entry.Key, entry.Val
+}
+
+// bucket returns the bucket of a hash
+func (s *FrozenHashMap[K, T]) bucket(hash uint64) int {
+	return reduce(types.Mix64(hash), len(s.displacements))
+}
+
+// slot returns the slot a hash is sent to by the seed of its bucket, every seed deriving a new hash from it
+func (s *FrozenHashMap[K, T]) slot(hash uint64, seed int32) int {
+	return reduce(types.Mix64(hash^uint64(seed)*0x9e3779b97f4a7c15), len(s.keys))
+}
+
+// reduce maps the hash to [0, n) with a multiplication, which is much faster than a modulo
+func reduce(hash uint64, n int) int {
+	hi, _ := bits.Mul64(hash, uint64(n))
+	return int(hi)
+}
+
+// lookup returns the slot of the key, where the key is if it is in the map at all
+func (s *FrozenHashMap[K, T]) lookup(key K) int {
+	hash := s.hasher.Hash(key)
+	d := s.displacements[s.bucket(hash)]
+	if d < 0 {
+		return int(-d - 1)
+	}
+	return s.slot(hash, d)
+}
+
+// Get returns the value of the key, or false if the key is not in the map
+func (s *FrozenHashMap[K, T]) Get(key K) (T, bool) {
+	if len(s.keys) == 0 {
+		var zero T
+		return zero, false
+	}
+	slot := s.lookup(key)
+	if !s.hasher.Equal(s.keys[slot], key) {
+		var zero T
+		return zero, false
+	}
+	return s.values[slot], true
+}
+
+// GetUnchecked returns the value of the key without checking that the key is in the map, saving the comparison
+// of the keys for lookups of keys known to be in it. Any other key gets the value of some key of the map,
+// or the zero value if the map is empty.
+func (s *FrozenHashMap[K, T]) GetUnchecked(key K) T {
+	if len(s.keys) == 0 {
+		var zero T
+		return zero
+	}
+	return s.values[s.lookup(key)]
+}
+
+// ContainsKey returns true if the key is in the map
+func (s *FrozenHashMap[K, T]) ContainsKey(key K) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+func (s *FrozenHashMap[K, T]) Size() int {
+	return len(s.keys)
+}
+
+func (s *FrozenHashMap[K, T]) IsEmpty() bool {
+	return len(s.keys) == 0
+}
+
+func (s *FrozenHashMap[K, T]) IsNotEmpty() bool {
+	return !s.IsEmpty()
+}
+
+// Formatted returns a string representation of the map
+func (s *FrozenHashMap[K, T]) Formatted() string {
+	return s.String()
+}
+
+// ForEach visits the entries, in no particular order, until visit returns false
+func (s *FrozenHashMap[K, T]) ForEach(visit func(key K, val T) bool) {
+	for i, key := range s.keys {
+		if !visit(key, s.values[i]) {
+			return
+		}
+	}
+}
+
+func (s *FrozenHashMap[K, T]) Entries() []Entry[K, T] {
+	entries := make([]Entry[K, T], len(s.keys))
+	for i, key := range s.keys {
+		entries[i] = Entry[K, T]{Key: key, Val: s.values[i]}
+	}
+	return entries
+}
+
+func (s *FrozenHashMap[K, T]) Keys() []K {
+	return append([]K(nil), s.keys...)
+}
+
+func (s *FrozenHashMap[K, T]) Values() []T {
+	return append([]T(nil), s.values...)
+}
@@ -0,0 +1,82 @@
+package dict
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestMakeFrozenHashMap(t *testing.T) {
+	routes := MakeDefaultHashMap[string, int]()
+	for i := 0; i < 1000; i++ {
+		routes.Put(fmt.Sprintf("/api/v1/resource%d", i), i)
+	}
+
+	m, err := MakeDefaultFrozenHashMap[string, int](routes)
+
+	assert.NoError(t, err)
+	assert.NoError(t, m.Validate())
+	assert.Equal(t, 1000, m.Size())
+	for _, entry := range routes.Entries() {
+		val, ok := m.Get(entry.Key)
+		assert.True(t, ok)
+		assert.Equal(t, entry.Val, val)
+		assert.Equal(t, entry.Val, m.GetUnchecked(entry.Key))
+	}
+	assert.ElementsMatch(t, routes.Entries(), m.Entries())
+	assert.ElementsMatch(t, routes.Keys(), m.Keys())
+}
+
+func TestFrozenHashMap_MissingKeys(t *testing.T) {
+	source := MakeDefaultHashMap[int, string]()
+	source.Put(1, "one")
+	source.Put(2, "two")
+	m, err := MakeFrozenHashMap(types.IntegerHasher[int](types.MakeDeterministicSeed(1)), Map[int, string](source))
+	assert.NoError(t, err)
+
+	for key := 3; key < 100; key++ {
+		assert.False(t, m.ContainsKey(key))
+	}
+	assert.Contains(t, []string{"one", "two"}, m.GetUnchecked(3))
+}
+
+func TestFrozenHashMap_Empty(t *testing.T) {
+	m, err := MakeDefaultFrozenHashMap[int, int](MakeDefaultHashMap[int, int]())
+
+	assert.NoError(t, err)
+	assert.True(t, m.IsEmpty())
+	assert.False(t, m.ContainsKey(0))
+	assert.Equal(t, 0, m.GetUnchecked(0))
+	assert.Equal(t, "{}", m.String())
+}
+
+func TestFrozenHashMap_SameHash(t *testing.T) {
+	source := MakeFlatMap[int, int](types.IntComparator)
+	source.Put(1, 1)
+	source.Put(2, 2)
+	// every key has the same hash, which no perfect hash can make up for
+	constant := types.MakeHasher(func(int) uint64 { return 7 }, types.Equals[int])
+
+	_, err := MakeFrozenHashMap[int, int](constant, source)
+
+	assert.EqualError(t, err, "dict: FrozenHashMap keys 1 and 2 have the same hash")
+}
+
+func TestFrozenHashMap_DuplicateKeys(t *testing.T) {
+	source := MakeFlatMap[int, int](types.IntComparator)
+	source.Put(1, 1)
+	source.Put(-1, 2)
+	// keys equal in absolute value are the same key for the hasher, but not for the source map
+	abs := func(i int) int {
+		if i < 0 {
+			return -i
+		}
+		return i
+	}
+	hasher := types.MakeHasher(func(i int) uint64 { return uint64(abs(i)) }, func(a, b int) bool { return abs(a) == abs(b) })
+
+	_, err := MakeFrozenHashMap[int, int](hasher, source)
+
+	assert.EqualError(t, err, "dict: FrozenHashMap key -1 is given twice")
+}
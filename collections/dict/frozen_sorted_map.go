@@ -0,0 +1,269 @@
+package dict
+
+import (
+	"math/bits"
+	"utils-generics/collections"
+)
+
+// FrozenSortedMap is a read-only sorted map meant for lookup tables built once, e.g. at startup, and then only read.
+//
+// The entries are laid out in Eytzinger order, the breadth-first order of a complete binary search tree stored in
+// an array: the root is at index 1 and the children of the entry at index i are at 2i and 2i+1. A search only ever
+// moves forward in the array, with the first levels of the tree sharing a few cache lines that stay hot across
+// searches, and its next step is computed rather than branched on, so it suffers fewer cache and branch misses
+// than the binary search of a FlatMap on large maps.
+//
+// It's performance characteristics are:
+//
+// - Get: O(log n)
+//
+// - Floor, Ceiling, Lower, Higher: O(log n)
+//
+// - Construction: O(n) from a FlatMap or a BinaryTreeMap, O(n log n) from unsorted entries
+type FrozenSortedMap[K any, T any] struct {
+	// keys and values hold the entries in Eytzinger order, index 0 is unused. The keys are kept apart so that
+	// a search reads as many of them as possible per cache line.
+	keys       []K
+	values     []T
+	comparator func(a, b K) int
+}
+
+// MakeFrozenSortedMap creates a new FrozenSortedMap holding the given entries, in any order.
+// When a key appears more than once the last of its entries wins, as it would with Put.
+func MakeFrozenSortedMap[K any, T any](c func(a, b K) int, entries []Entry[K, T]) *FrozenSortedMap[K, T] {
+	return makeFrozenSortedMap(c, sortEntries(entries, c))
+}
+
+// Freeze returns a FrozenSortedMap holding the entries of the map, which can keep being modified independently
+func (s *FlatMap[K, T]) Freeze() *FrozenSortedMap[K, T] {
+	return makeFrozenSortedMap(s.comparator, s.array)
+}
+
+// Freeze returns a FrozenSortedMap holding the entries of the map, which can keep being modified independently
+func (s *BinaryTreeMap[K, T]) Freeze() *FrozenSortedMap[K, T] {
+	return makeFrozenSortedMap(s.comparator, s.Entries())
+}
+
+// makeFrozenSortedMap lays out the entries, sorted by strictly increasing keys, in Eytzinger order
+func makeFrozenSortedMap[K any, T any](c func(a, b K) int, sorted []Entry[K, T]) *FrozenSortedMap[K, T] {
+	s := &FrozenSortedMap[K, T]{keys: make([]K, len(sorted)+1), values: make([]T, len(sorted)+1), comparator: c}
+	// an in-order walk of the tree visits the entries in key order
+	next := 0
+	var fill func(i int)
+	fill = func(i int) {
+		if i >= len(s.keys) {
+			return
+		}
+		fill(2 * i)
+		s.keys[i], s.values[i] = sorted[next].Key, sorted[next].Val
+		next++
+		fill(2*i + 1)
+	}
+	fill(1)
+
+	if collections.Debug {
+		collections.MustBeValid(s)
+	}
+	return s
+}
+
+// search returns the index of the first entry, in key order, whose key is greater than or equal to the key when
+// inclusive and strictly greater otherwise, or 0 if there is none
+func (s *FrozenSortedMap[K, T]) search(key K, inclusive bool) int {
+	// go right past the keys less than the key, and past the key itself unless inclusive
+	bound := 1
+	if inclusive {
+		bound = 0
+	}
+	keys := s.keys
+	i := 1
+	for i < len(keys) {
+		goRight := 0
+		if s.comparator(keys[i], key) < bound {
+			goRight = 1
+		}
+		i = 2*i + goRight
+	}
+	// the search went past a leaf: the entry found is where it last turned left, so the right turns taken since,
+	// the trailing ones, and that left turn are undone
+	return i >> (bits.TrailingZeros(^uint(i)) + 1)
+}
+
+// first returns the index of the leftmost entry of the subtree rooted at index i
+func (s *FrozenSortedMap[K, T]) first(i int) int {
+	for 2*i < len(s.keys) {
+		i = 2 * i
+	}
+	return i
+}
+
+// last returns the index of the rightmost entry of the subtree rooted at index i
+func (s *FrozenSortedMap[K, T]) last(i int) int {
+	for 2*i+1 < len(s.keys) {
+		i = 2*i + 1
+	}
+	return i
+}
+
+// next returns the index of the entry following the one at index i in key order, or the first entry if i is 0,
+// or 0 if there is none
+func (s *FrozenSortedMap[K, T]) next(i int) int {
+	if 2*i+1 < len(s.keys) {
+		return s.first(2*i + 1)
+	}
+	// go up until coming from a left child
+	for i&1 == 1 {
+		i >>= 1
+	}
+	return i >> 1
+}
+
+// prev returns the index of the entry preceding the one at index i in key order, or the last entry if i is 0,
+// or 0 if there is none
+func (s *FrozenSortedMap[K, T]) prev(i int) int {
+	if len(s.keys) == 1 {
+		return 0
+	}
+	if i == 0 {
+		return s.last(1)
+	}
+	if 2*i < len(s.keys) {
+		return s.last(2 * i)
+	}
+	// go up until coming from a right child
+	for i > 1 && i&1 == 0 {
+		i >>= 1
+	}
+	return i >> 1
+}
+
+// entryAt returns the entry at index i, or false if i is 0
+func (s *FrozenSortedMap[K, T]) entryAt(i int) (Entry[K, T], bool) {
+	if i == 0 {
+		return Entry[K, T]{}, false
+	}
+	return Entry[K, T]{Key: s.keys[i], Val: s.values[i]}, true
+}
+
+// Get returns the value of the key, or false if the key is not in the map
+func (s *FrozenSortedMap[K, T]) Get(key K) (T, bool) {
+	i := s.search(key, true)
+	if i == 0 || s.comparator(s.keys[i], key) != 0 {
+		var zero T
+		return zero, false
+	}
+	return s.values[i], true
+}
+
+// ContainsKey returns true if the key is in the map
+func (s *FrozenSortedMap[K, T]) ContainsKey(key K) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+func (s *FrozenSortedMap[K, T]) Size() int {
+	return len(s.keys) - 1
+}
+
+func (s *FrozenSortedMap[K, T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *FrozenSortedMap[K, T]) IsNotEmpty() bool {
+	return !s.IsEmpty()
+}
+
+// Formatted returns a string representation of the map, in key order
+func (s *FrozenSortedMap[K, T]) Formatted() string {
+	return s.String()
+}
+
+// ForEach visits the entries in key order until visit returns false
+func (s *FrozenSortedMap[K, T]) ForEach(visit func(key K, val T) bool) {
+	for i := s.next(0); i != 0; i = s.next(i) {
+		if !visit(s.keys[i], s.values[i]) {
+			return
+		}
+	}
+}
+
+// Entries returns the entries of the map in key order
+func (s *FrozenSortedMap[K, T]) Entries() []Entry[K, T] {
+	entries := make([]Entry[K, T], 0, s.Size())
+	s.ForEach(func(key K, val T) bool {
+		entries = append(entries, Entry[K, T]{Key: key, Val: val})
+		return true
+	})
+	return entries
+}
+
+// Keys returns the keys of the map in order
+func (s *FrozenSortedMap[K, T]) Keys() []K {
+	keys := make([]K, 0, s.Size())
+	s.ForEach(func(key K, _ T) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns the values of the map in key order
+func (s *FrozenSortedMap[K, T]) Values() []T {
+	values := make([]T, 0, s.Size())
+	s.ForEach(func(_ K, val T) bool {
+		values = append(values, val)
+		return true
+	})
+	return values
+}
+
+// First returns the first entry of the map
+func (s *FrozenSortedMap[K, T]) First() (K, T) {
+	entry, _ := s.entryAt(s.next(0))
+	return entry.Key, entry.Val
+}
+
+// Last returns the last entry of the map
+func (s *FrozenSortedMap[K, T]) Last() (K, T) {
+	entry, _ := s.entryAt(s.prev(0))
+	return entry.Key, entry.Val
+}
+
+// Floor returns the entry with the greatest key less than or equal to the given key, if any
+//
+// Time complexity: O(log n)
+func (s *FrozenSortedMap[K, T]) Floor(key K) (Entry[K, T], bool) {
+	return s.entryAt(s.prev(s.search(key, false)))
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the given key, if any
+//
+// Time complexity: O(log n)
+func (s *FrozenSortedMap[K, T]) Ceiling(key K) (Entry[K, T], bool) {
+	return s.entryAt(s.search(key, true))
+}
+
+// Lower returns the entry with the greatest key strictly less than the given key, if any
+//
+// Time complexity: O(log n)
+func (s *FrozenSortedMap[K, T]) Lower(key K) (Entry[K, T], bool) {
+	return s.entryAt(s.prev(s.search(key, true)))
+}
+
+// Higher returns the entry with the smallest key strictly greater than the given key, if any
+//
+// Time complexity: O(log n)
+func (s *FrozenSortedMap[K, T]) Higher(key K) (Entry[K, T], bool) {
+	return s.entryAt(s.search(key, false))
+}
+
+// Range returns, in order, the entries whose keys are in the half-open interval [from, to)
+//
+// Time complexity: O(log n + m) where m is the number of entries returned
+func (s *FrozenSortedMap[K, T]) Range(from, to K) []Entry[K, T] {
+	var entries []Entry[K, T]
+	for i := s.search(from, true); i != 0 && s.comparator(s.keys[i], to) < 0; i = s.next(i) {
+		entries = append(entries, Entry[K, T]{Key: s.keys[i], Val: s.values[i]})
+	}
+	return entries
+}
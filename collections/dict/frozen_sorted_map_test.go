@@ -0,0 +1,106 @@
+package dict
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestMakeFrozenSortedMap(t *testing.T) {
+	m := MakeFrozenSortedMap(types.StringComparator, []Entry[string, int]{{"b", 2}, {"a", 1}, {"c", 3}, {"a", 0}})
+
+	assert.Equal(t, 3, m.Size())
+	assert.Equal(t, []Entry[string, int]{{"a", 0}, {"b", 2}, {"c", 3}}, m.Entries())
+	assert.Equal(t, []string{"a", "b", "c"}, m.Keys())
+	assert.Equal(t, []int{0, 2, 3}, m.Values())
+	assert.Equal(t, "{a: 0, b: 2, c: 3}", m.Formatted())
+
+	val, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+	assert.False(t, m.ContainsKey("d"))
+}
+
+func TestFrozenSortedMap_Empty(t *testing.T) {
+	m := MakeFrozenSortedMap[int, int](types.IntComparator, nil)
+
+	assert.True(t, m.IsEmpty())
+	assert.False(t, m.ContainsKey(1))
+	_, ok := m.Floor(1)
+	assert.False(t, ok)
+	_, ok = m.Ceiling(1)
+	assert.False(t, ok)
+	assert.Empty(t, m.Entries())
+	assert.Equal(t, "{}", m.String())
+}
+
+func TestFrozenSortedMap_Freeze(t *testing.T) {
+	flat := MakeFlatMap[int, string](types.IntComparator)
+	tree := MakeBinaryTreeMap[int, string](types.IntComparator)
+	for _, key := range []int{5, 2, 8, 1} {
+		flat.Put(key, "v")
+		tree.Put(key, "v")
+	}
+
+	frozenFlat, frozenTree := flat.Freeze(), tree.Freeze()
+	flat.Put(3, "v")
+	tree.Remove(5)
+
+	assert.Equal(t, []int{1, 2, 5, 8}, frozenFlat.Keys())
+	assert.Equal(t, []int{1, 2, 5, 8}, frozenTree.Keys())
+	first, _ := frozenTree.First()
+	last, _ := frozenTree.Last()
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 8, last)
+}
+
+// TestFrozenSortedMap_Navigation checks every query against a sorted slice, for trees of every shape
+// from empty to a few complete levels
+func TestFrozenSortedMap_Navigation(t *testing.T) {
+	for n := 0; n <= 33; n++ {
+		// the keys are the even numbers, so that the odd ones fall between them
+		keys := make([]int, n)
+		entries := make([]Entry[int, int], n)
+		for i := range keys {
+			keys[i] = 2 * i
+			entries[i] = Entry[int, int]{Key: 2 * i, Val: i}
+		}
+		m := MakeFrozenSortedMap(types.IntComparator, entries)
+		assert.NoError(t, m.Validate())
+		assert.Equal(t, entries, m.Entries())
+
+		for key := -1; key <= 2*n; key++ {
+			// the index of the first key greater than or equal to, and strictly greater than, the key
+			ge := sort.SearchInts(keys, key)
+			gt := sort.SearchInts(keys, key+1)
+
+			checkEntry(t, "Ceiling", n, key, m.Ceiling, keys, ge)
+			checkEntry(t, "Higher", n, key, m.Higher, keys, gt)
+			checkEntry(t, "Floor", n, key, m.Floor, keys, gt-1)
+			checkEntry(t, "Lower", n, key, m.Lower, keys, ge-1)
+
+			_, ok := m.Get(key)
+			assert.Equal(t, key >= 0 && key%2 == 0 && key < 2*n, ok, "Get(%d) with %d keys", key, n)
+		}
+
+		var expected []Entry[int, int]
+		for _, entry := range entries {
+			if entry.Key >= 1 && entry.Key < 8 {
+				expected = append(expected, entry)
+			}
+		}
+		assert.Equal(t, expected, m.Range(1, 8), "Range with %d keys", n)
+	}
+}
+
+func checkEntry(t *testing.T, name string, n, key int, query func(int) (Entry[int, int], bool), keys []int, index int) {
+	entry, ok := query(key)
+	if index < 0 || index >= len(keys) {
+		assert.False(t, ok, "%s(%d) with %d keys", name, key, n)
+		return
+	}
+	if assert.True(t, ok, "%s(%d) with %d keys", name, key, n) {
+		assert.Equal(t, keys[index], entry.Key, "%s(%d) with %d keys", name, key, n)
+	}
+}
@@ -0,0 +1,146 @@
+package dict
+
+import (
+	"sort"
+	"testing"
+	"utils-generics/collections"
+	"utils-generics/collections/types"
+)
+
+// mapOperation decodes the fuzz input two bytes at a time: an operation and a key.
+// The operation byte 0xff clears the map.
+type mapOperation struct {
+	code byte
+	key  byte
+}
+
+func mapOperations(data []byte) []mapOperation {
+	ops := make([]mapOperation, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		ops = append(ops, mapOperation{code: data[i], key: data[i+1]})
+	}
+	return ops
+}
+
+// fuzzMap runs the operations against the map and a builtin map, failing as soon as they disagree
+// or the map breaks one of its invariants. Ordered maps also get RemoveFirst and RemoveLast operations.
+func fuzzMap(t *testing.T, m Map[byte, int], data []byte) {
+	ordered, _ := m.(interface {
+		RemoveFirst() bool
+		RemoveLast() bool
+	})
+	model := map[byte]int{}
+	for i, op := range mapOperations(data) {
+		switch {
+		case op.code == 0xff:
+			m.Clear()
+			model = map[byte]int{}
+		case op.code%8 < 3:
+			m.Put(op.key, i)
+			model[op.key] = i
+		case op.code%8 < 5:
+			_, ok := model[op.key]
+			if removed := m.Remove(op.key); removed != ok {
+				t.Fatalf("operation %d: Remove(%d) = %t, expected %t", i, op.key, removed, ok)
+			}
+			delete(model, op.key)
+		case op.code%8 == 5 || ordered == nil:
+			expected, ok := model[op.key]
+			if val, found := m.Get(op.key); found != ok || val != expected {
+				t.Fatalf("operation %d: Get(%d) = %d, %t, expected %d, %t", i, op.key, val, found, expected, ok)
+			}
+			if m.ContainsKey(op.key) != ok {
+				t.Fatalf("operation %d: ContainsKey(%d) = %t", i, op.key, !ok)
+			}
+		default:
+			keys := sortedModelKeys(model)
+			var removed bool
+			if op.code%8 == 6 {
+				removed = ordered.RemoveFirst()
+				if len(keys) > 0 {
+					delete(model, keys[0])
+				}
+			} else {
+				removed = ordered.RemoveLast()
+				if len(keys) > 0 {
+					delete(model, keys[len(keys)-1])
+				}
+			}
+			if removed != (len(keys) > 0) {
+				t.Fatalf("operation %d: removing an end of %d keys returned %t", i, len(keys), removed)
+			}
+		}
+
+		if m.Size() != len(model) {
+			t.Fatalf("operation %d: Size() = %d, expected %d", i, m.Size(), len(model))
+		}
+		if err := m.(collections.Validator).Validate(); err != nil {
+			t.Fatalf("operation %d: %v", i, err)
+		}
+	}
+
+	for _, entry := range m.Entries() {
+		if val, ok := model[entry.Key]; !ok || val != entry.Val {
+			t.Fatalf("entry %d: %d is not in the model", entry.Key, entry.Val)
+		}
+	}
+}
+
+func sortedModelKeys(model map[byte]int) []byte {
+	keys := make([]byte, 0, len(model))
+	for key := range model {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func FuzzHashMap(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// a narrow hash makes the keys share buckets
+		fuzzMap(t, MakeComparableHashMap[byte, int](func(key byte) int { return int(key % 3) }), data)
+	})
+}
+
+func FuzzFlatHashMap(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// a narrow hash makes long probe sequences, shifted back and forth by the removals
+		fuzzMap(t, MakeComparableFlatHashMap[byte, int](func(key byte) int { return int(key % 3) }), data)
+	})
+}
+
+func FuzzBinaryTreeMap(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m := MakeBinaryTreeMap[byte, int](types.ByteComparator)
+		fuzzMap(t, m, data)
+
+		keys := m.Keys()
+		if !sort.SliceIsSorted(keys, func(i, j int) bool { return keys[i] < keys[j] }) {
+			t.Fatalf("Keys() = %v is not sorted", keys)
+		}
+	})
+}
+
+func FuzzSkipListMap(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m := MakeSkipListMapWithSeed[byte, int](types.ByteComparator, 1)
+		fuzzMap(t, m, data)
+
+		keys := m.Keys()
+		if !sort.SliceIsSorted(keys, func(i, j int) bool { return keys[i] < keys[j] }) {
+			t.Fatalf("Keys() = %v is not sorted", keys)
+		}
+	})
+}
+
+func FuzzFlatMap(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m := MakeFlatMap[byte, int](types.ByteComparator)
+		fuzzMap(t, m, data)
+
+		keys := m.Keys()
+		if !sort.SliceIsSorted(keys, func(i, j int) bool { return keys[i] < keys[j] }) {
+			t.Fatalf("Keys() = %v is not sorted", keys)
+		}
+	})
+}
@@ -1,8 +1,8 @@
 package dict
 
 import (
-	"fmt"
-	"reflect"
+	"utils-generics/collections"
+	"utils-generics/collections/types"
 )
 
 const defaultHashTableSize = 128
@@ -14,18 +14,60 @@ type hashTableEntry[K any, T any] struct {
 
 type HashMap[K any, T any] struct {
 	table  [defaultHashTableSize]*hashTableEntry[K, T]
-	hasher func(K) int
+	hasher func(K) uint64
+	equals func(a, b K) bool
 }
 
+// MakeHashMap creates a new HashMap that compares keys with reflect.DeepEqual.
+//
+// DeepEqual works for every key type but is slow, see MakeHashMapWithEquals and MakeComparableHashMap
+// for faster alternatives.
 func MakeHashMap[K any, T any](h func(K) int) *HashMap[K, T] {
-	return &HashMap[K, T]{hasher: h}
+	return MakeHashMapWithEquals[K, T](h, types.DeepEquals[K])
+}
+
+// MakeHashMapWithEquals creates a new HashMap that compares keys with the provided function,
+// which must agree with the hasher i.e. equal keys must have equal hashes
+func MakeHashMapWithEquals[K any, T any](h func(K) int, equals func(a, b K) bool) *HashMap[K, T] {
+	return &HashMap[K, T]{hasher: func(key K) uint64 { return uint64(h(key)) }, equals: equals}
+}
+
+// MakeComparableHashMap creates a new HashMap for comparable keys, comparing them with ==
+func MakeComparableHashMap[K comparable, T any](h func(K) int) *HashMap[K, T] {
+	return MakeHashMapWithEquals[K, T](h, types.Equals[K])
+}
+
+// MakeHashMapWithHasher creates a new HashMap that hashes and compares keys with the provided Hasher
+func MakeHashMapWithHasher[K any, T any](h types.Hasher[K]) *HashMap[K, T] {
+	return &HashMap[K, T]{hasher: h.Hash, equals: h.Equal}
+}
+
+// MakeDefaultHashMap creates a new HashMap for string or integer keys, hashed with a randomly seeded hasher
+// so that keys colliding in one map do not collide in any other. See types.DefaultHasher.
+func MakeDefaultHashMap[K comparable, T any]() *HashMap[K, T] {
+	return MakeHashMapWithHasher[K, T](types.DefaultHasher[K]())
+}
+
+// MakeHashableMap creates a new HashMap for keys that implement types.Hashable, delegating hashing and comparison
+// to the keys themselves
+func MakeHashableMap[K types.Hashable[K], T any]() *HashMap[K, T] {
+	return MakeHashMapWithHasher[K, T](types.HashableHasher[K]())
+}
+
+// bucket returns the index of the table slot for the key
+func (s *HashMap[K, T]) bucket(key K) uint64 {
+	return s.hasher(key) % defaultHashTableSize
 }
 
 // Put adds a new entry to the map.
 //
 // If an entry with the key already exists the value is updated with the one provided
 func (s *HashMap[K, T]) Put(key K, val T) {
-	hash := s.hasher(key) % defaultHashTableSize
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	hash := s.bucket(key)
 	node := s.table[hash]
 	if node == nil {
 		s.table[hash] = &hashTableEntry[K, T]{Entry: Entry[K, T]{Key: key, Val: val}}
@@ -33,7 +75,7 @@ func (s *HashMap[K, T]) Put(key K, val T) {
 	}
 
 	for {
-		if reflect.DeepEqual(node.Key, key) {
+		if s.equals(node.Key, key) {
 			node.Val = val
 			return
 		}
@@ -48,7 +90,11 @@ func (s *HashMap[K, T]) Put(key K, val T) {
 // Remove removes the entry identified by the key, returning true if the entry was found and removed
 // and false if the entry was not found
 func (s *HashMap[K, T]) Remove(key K) bool {
-	hash := s.hasher(key) % defaultHashTableSize
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	hash := s.bucket(key)
 	node := s.table[hash]
 	if node == nil {
 		return false
@@ -56,7 +102,7 @@ func (s *HashMap[K, T]) Remove(key K) bool {
 
 	var prev *hashTableEntry[K, T]
 	for {
-		if reflect.DeepEqual(node.Key, key) {
+		if s.equals(node.Key, key) {
 			if prev == nil {
 				s.table[hash] = node.next
 			} else {
@@ -74,14 +120,14 @@ func (s *HashMap[K, T]) Remove(key K) bool {
 
 // ContainsKey returns true if the map contains an entry with the provided key and false if otherwise
 func (s *HashMap[K, T]) ContainsKey(key K) bool {
-	hash := s.hasher(key) % defaultHashTableSize
+	hash := s.bucket(key)
 	node := s.table[hash]
 	if node == nil {
 		return false
 	}
 
 	for {
-		if reflect.DeepEqual(node.Key, key) {
+		if s.equals(node.Key, key) {
 			return true
 		}
 		if node.next == nil {
@@ -94,14 +140,14 @@ func (s *HashMap[K, T]) ContainsKey(key K) bool {
 // Get returns the value associated with the provided key and true if the key was found and false if otherwise
 func (s *HashMap[K, T]) Get(key K) (T, bool) {
 	var zero T
-	hash := s.hasher(key) % defaultHashTableSize
+	hash := s.bucket(key)
 	node := s.table[hash]
 	if node == nil {
 		return zero, false
 	}
 
 	for {
-		if reflect.DeepEqual(node.Key, key) {
+		if s.equals(node.Key, key) {
 			return node.Val, true
 		}
 		if node.next == nil {
@@ -138,22 +184,7 @@ func (s *HashMap[K, T]) IsNotEmpty() bool {
 
 // Formatted returns a string representation of the map
 func (s *HashMap[K, T]) Formatted() string {
-	str := "{"
-	for _, node := range s.table {
-		if node != nil {
-			str += fmt.Sprintf("%T: %T, ", node.Key, node.Val)
-			for node.next != nil {
-				str += ", " + fmt.Sprintf("%T: %T, ", node.Key, node.Val)
-				node = node.next
-			}
-		}
-	}
-	// remove last comma and space str if it exists
-	if len(str) > 1 {
-		str = str[:len(str)-2]
-	}
-	str += "}"
-	return str
+	return s.String()
 }
 
 // Clear removes all entries from the map
@@ -165,12 +196,8 @@ func (s *HashMap[K, T]) Clear() {
 func (s *HashMap[K, T]) Entries() []Entry[K, T] {
 	entries := make([]Entry[K, T], 0, s.Size())
 	for _, node := range s.table {
-		if node != nil {
+		for ; node != nil; node = node.next {
 			entries = append(entries, Entry[K, T]{Key: node.Key, Val: node.Val})
-			for node.next != nil {
-				entries = append(entries, Entry[K, T]{Key: node.Key, Val: node.Val})
-				node = node.next
-			}
 		}
 	}
 	return entries
@@ -180,12 +207,8 @@ func (s *HashMap[K, T]) Entries() []Entry[K, T] {
 func (s *HashMap[K, T]) Keys() []K {
 	keys := make([]K, 0, s.Size())
 	for _, node := range s.table {
-		if node != nil {
+		for ; node != nil; node = node.next {
 			keys = append(keys, node.Key)
-			for node.next != nil {
-				keys = append(keys, node.Key)
-				node = node.next
-			}
 		}
 	}
 	return keys
@@ -195,12 +218,8 @@ func (s *HashMap[K, T]) Keys() []K {
 func (s *HashMap[K, T]) Values() []T {
 	values := make([]T, 0, s.Size())
 	for _, node := range s.table {
-		if node != nil {
+		for ; node != nil; node = node.next {
 			values = append(values, node.Val)
-			for node.next != nil {
-				values = append(values, node.Val)
-				node = node.next
-			}
 		}
 	}
 	return values
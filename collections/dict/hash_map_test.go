@@ -2,6 +2,8 @@ package dict
 
 import (
 	"github.com/stretchr/testify/assert"
+	"strconv"
+	"strings"
 	"testing"
 	"utils-generics/collections/types"
 )
@@ -91,10 +93,15 @@ func TestHashMap_Get(t *testing.T) {
 	assert.Equal(t, "", val)
 }
 
+// identityHash keeps int keys in predictable buckets, to force collisions
+func identityHash(i int) int {
+	return i
+}
+
 func TestHashMap_Collisions(t *testing.T) {
-	var m Map[int, string] = MakeHashMap[int, string](types.IntHash)
+	var m Map[int, string] = MakeHashMap[int, string](identityHash)
 	m.Put(1, "one")
-	m.Put(128+1, "one hundred twenty eight plus one") // this should collide with one -- check if the defaults changed and this is still the case
+	m.Put(128+1, "one hundred twenty eight plus one") // this collides with one, as the identity puts both in bucket 1
 
 	val, ok := m.Get(1)
 	assert.True(t, ok)
@@ -105,3 +112,132 @@ func TestHashMap_Collisions(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, "one hundred twenty eight plus one", val)
 }
+
+func TestHashMap_EntriesWithCollisions(t *testing.T) {
+	var m Map[int, string] = MakeHashMap[int, string](identityHash)
+	m.Put(1, "one")
+	m.Put(128+1, "one hundred twenty nine")
+	m.Put(256+1, "two hundred fifty seven")
+
+	assert.ElementsMatch(t, []int{1, 129, 257}, m.Keys())
+	assert.ElementsMatch(t, []string{"one", "one hundred twenty nine", "two hundred fifty seven"}, m.Values())
+	assert.Len(t, m.Entries(), 3)
+}
+
+func TestHashMap_CustomEquals(t *testing.T) {
+	lower := func(s string) int { return types.StringHash(strings.ToLower(s)) }
+	var m Map[string, int] = MakeHashMapWithEquals[string, int](lower, strings.EqualFold)
+	m.Put("Go", 1)
+	m.Put("GO", 2)
+
+	assert.Equal(t, 1, m.Size())
+	val, ok := m.Get("go")
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+	assert.True(t, m.Remove("gO"))
+}
+
+func TestHashMap_Comparable(t *testing.T) {
+	var m Map[int, string] = MakeComparableHashMap[int, string](identityHash)
+	m.Put(1, "one")
+	m.Put(128+1, "one hundred twenty nine")
+
+	assert.True(t, m.ContainsKey(129))
+	assert.False(t, m.ContainsKey(257))
+	assert.True(t, m.Remove(1))
+	assert.Equal(t, 1, m.Size())
+}
+
+func benchmarkHashMapGet(b *testing.B, m Map[int, int]) {
+	for i := 0; i < 1024; i++ {
+		m.Put(i, i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Get(i & 1023)
+	}
+}
+
+func BenchmarkHashMap_Get_DeepEqual(b *testing.B) {
+	benchmarkHashMapGet(b, MakeHashMap[int, int](types.IntHash))
+}
+
+func BenchmarkHashMap_Get_Equals(b *testing.B) {
+	benchmarkHashMapGet(b, MakeHashMapWithEquals[int, int](types.IntHash, func(a, b int) bool { return a == b }))
+}
+
+func BenchmarkHashMap_Get_Comparable(b *testing.B) {
+	benchmarkHashMapGet(b, MakeComparableHashMap[int, int](types.IntHash))
+}
+
+type point struct {
+	x, y int
+}
+
+func (p point) Hash() uint64 {
+	return uint64(p.x)*31 + uint64(p.y)
+}
+
+func (p point) Equal(other point) bool {
+	return p == other
+}
+
+func TestHashMap_Hashable(t *testing.T) {
+	var m Map[point, string] = MakeHashableMap[point, string]()
+	m.Put(point{1, 2}, "a")
+	m.Put(point{2, 1}, "b")
+	m.Put(point{1, 2}, "c")
+
+	assert.Equal(t, 2, m.Size())
+	val, ok := m.Get(point{1, 2})
+	assert.True(t, ok)
+	assert.Equal(t, "c", val)
+}
+
+func TestHashMap_WithHasher(t *testing.T) {
+	var m Map[string, int] = MakeHashMapWithHasher[string, int](types.MakeHasher[string](types.StringHash64, types.Equals[string]))
+	m.Put("one", 1)
+	m.Put("two", 2)
+
+	val, ok := m.Get("two")
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+	assert.True(t, m.Remove("one"))
+	assert.False(t, m.ContainsKey("one"))
+}
+
+func TestHashMap_NegativeHashes(t *testing.T) {
+	var m Map[int, string] = MakeHashMap[int, string](identityHash)
+	m.Put(-1, "minus one")
+	m.Put(-129, "minus one hundred twenty nine")
+
+	val, ok := m.Get(-1)
+	assert.True(t, ok)
+	assert.Equal(t, "minus one", val)
+	assert.True(t, m.ContainsKey(-129))
+}
+
+func TestHashMap_Default(t *testing.T) {
+	var m Map[string, int] = MakeDefaultHashMap[string, int]()
+	for i := 0; i < 1000; i++ {
+		m.Put(strconv.Itoa(i), i)
+	}
+
+	assert.Equal(t, 1000, m.Size())
+	val, ok := m.Get("500")
+	assert.True(t, ok)
+	assert.Equal(t, 500, val)
+}
+
+func TestHashMap_DeterministicSeed(t *testing.T) {
+	seed := types.MakeDeterministicSeed(42)
+	a := MakeHashMapWithHasher[string, int](types.StringHasher(seed))
+	b := MakeHashMapWithHasher[string, int](types.StringHasher(seed))
+	for i := 0; i < 100; i++ {
+		a.Put(strconv.Itoa(i), i)
+		b.Put(strconv.Itoa(i), i)
+	}
+
+	assert.Equal(t, a.Keys(), b.Keys())
+}
@@ -0,0 +1,234 @@
+package dict
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// File: json.go
+// JSON encoding of the maps.
+//
+// Maps with string-like keys encode as JSON objects, any other map as an array of {"key": .., "val": ..} objects,
+// in the iteration order of the map. Hashers and comparators cannot be encoded, so maps must be created
+// with their Make function before unmarshalling into them; unmarshalling replaces their contents.
+
+// isStringKey returns true if keys of type K are encoded as the names of a JSON object
+func isStringKey[K any]() bool {
+	return reflect.TypeOf((*K)(nil)).Elem().Kind() == reflect.String
+}
+
+// MarshalEntries encodes entries as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise, keeping the order of the entries
+func MarshalEntries[K any, T any](entries []Entry[K, T]) ([]byte, error) {
+	if !isStringKey[K]() {
+		if entries == nil {
+			entries = []Entry[K, T]{}
+		}
+		return json.Marshal(entries)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, entry := range entries {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(reflect.ValueOf(entry.Key).String())
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(entry.Val)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalEntries decodes entries encoded by MarshalEntries, in the order they appear in the data
+func UnmarshalEntries[K any, T any](data []byte) ([]Entry[K, T], error) {
+	if !isStringKey[K]() {
+		var entries []Entry[K, T]
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('{') {
+		return nil, fmt.Errorf("dict: expected a JSON object, got %v", tok)
+	}
+
+	var entries []Entry[K, T]
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		var entry Entry[K, T]
+		// object names are always strings, and K is string-like
+		reflect.ValueOf(&entry.Key).Elem().SetString(tok.(string))
+		if err := dec.Decode(&entry.Val); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func errNotConstructed(name string) error {
+	return fmt.Errorf("dict: cannot unmarshal JSON into a %s that was not created with a Make function", name)
+}
+
+// MarshalJSON encodes the map as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise
+func (s *HashMap[K, T]) MarshalJSON() ([]byte, error) {
+	return MarshalEntries(s.Entries())
+}
+
+// UnmarshalJSON replaces the entries of the map with the decoded ones.
+// The map must have been created with one of the Make functions, as its hasher cannot be decoded.
+func (s *HashMap[K, T]) UnmarshalJSON(data []byte) error {
+	if s.hasher == nil {
+		return errNotConstructed("HashMap")
+	}
+	entries, err := UnmarshalEntries[K, T](data)
+	if err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the map in key order, as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise
+func (s *BinaryTreeMap[K, T]) MarshalJSON() ([]byte, error) {
+	return MarshalEntries(s.Entries())
+}
+
+// UnmarshalJSON replaces the entries of the map with the decoded ones.
+// The map must have been created with MakeBinaryTreeMap, as its comparator cannot be decoded.
+func (s *BinaryTreeMap[K, T]) UnmarshalJSON(data []byte) error {
+	if s.comparator == nil {
+		return errNotConstructed("BinaryTreeMap")
+	}
+	entries, err := UnmarshalEntries[K, T](data)
+	if err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the map in key order, as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise
+func (s *FlatMap[K, T]) MarshalJSON() ([]byte, error) {
+	return MarshalEntries(s.Entries())
+}
+
+// UnmarshalJSON replaces the entries of the map with the decoded ones.
+// The map must have been created with MakeFlatMap, as its comparator cannot be decoded.
+func (s *FlatMap[K, T]) UnmarshalJSON(data []byte) error {
+	if s.comparator == nil {
+		return errNotConstructed("FlatMap")
+	}
+	entries, err := UnmarshalEntries[K, T](data)
+	if err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise
+func (s *FlatHashMap[K, T]) MarshalJSON() ([]byte, error) {
+	return MarshalEntries(s.Entries())
+}
+
+// UnmarshalJSON replaces the entries of the map with the decoded ones.
+// The map must have been created with one of the Make functions, as its hasher cannot be decoded.
+func (s *FlatHashMap[K, T]) UnmarshalJSON(data []byte) error {
+	if s.hasher == nil {
+		return errNotConstructed("FlatHashMap")
+	}
+	entries, err := UnmarshalEntries[K, T](data)
+	if err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the map in key order, as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise
+func (s *SkipListMap[K, T]) MarshalJSON() ([]byte, error) {
+	return MarshalEntries(s.Entries())
+}
+
+// UnmarshalJSON replaces the entries of the map with the decoded ones.
+// The map must have been created with one of the Make functions, as its comparator cannot be decoded.
+func (s *SkipListMap[K, T]) UnmarshalJSON(data []byte) error {
+	if s.comparator == nil {
+		return errNotConstructed("SkipListMap")
+	}
+	entries, err := UnmarshalEntries[K, T](data)
+	if err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Put(entry.Key, entry.Val)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the key to value mapping, as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise
+func (s *BiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return MarshalEntries(s.Entries())
+}
+
+// UnmarshalJSON replaces the entries of the map with the decoded ones.
+// The map must have been created with one of the Make functions, as its hashers or comparators cannot be decoded.
+// It returns an error, leaving the map untouched, if two keys are bound to the same value.
+func (s *BiMap[K, V]) UnmarshalJSON(data []byte) error {
+	if s.forward == nil {
+		return errNotConstructed("BiMap")
+	}
+	entries, err := UnmarshalEntries[K, V](data)
+	if err != nil {
+		return err
+	}
+	return s.replace(entries)
+}
@@ -0,0 +1,142 @@
+package dict
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+type label string
+
+func TestHashMap_JSON_StringKeys(t *testing.T) {
+	m := MakeHashMap[string, int](types.StringHash)
+	m.Put("one", 1)
+	m.Put("two", 2)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"one": 1, "two": 2}`, string(data))
+
+	decoded := MakeHashMap[string, int](types.StringHash)
+	decoded.Put("stale", 0)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.ElementsMatch(t, m.Entries(), decoded.Entries())
+}
+
+func TestHashMap_JSON_NonStringKeys(t *testing.T) {
+	m := MakeHashMap[int, string](types.IntHash)
+	m.Put(1, "one")
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"key": 1, "val": "one"}]`, string(data))
+
+	decoded := MakeHashMap[int, string](types.IntHash)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+}
+
+func TestHashMap_JSON_Empty(t *testing.T) {
+	data, err := json.Marshal(MakeHashMap[int, string](types.IntHash))
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	data, err = json.Marshal(MakeHashMap[string, string](types.StringHash))
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+}
+
+func TestHashMap_JSON_NotConstructed(t *testing.T) {
+	var holder struct {
+		M *HashMap[string, int] `json:"m"`
+	}
+	err := json.Unmarshal([]byte(`{"m": {"a": 1}}`), &holder)
+	assert.Error(t, err)
+
+	holder.M = MakeHashMap[string, int](types.StringHash)
+	assert.NoError(t, json.Unmarshal([]byte(`{"m": {"a": 1}}`), &holder))
+	val, _ := holder.M.Get("a")
+	assert.Equal(t, 1, val)
+}
+
+func TestHashMap_JSON_InvalidLeavesMapUntouched(t *testing.T) {
+	m := MakeHashMap[string, int](types.StringHash)
+	m.Put("a", 1)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"b": "not a number"}`), m))
+	assert.Error(t, json.Unmarshal([]byte(`[1, 2]`), m))
+	assert.Equal(t, []Entry[string, int]{{"a", 1}}, m.Entries())
+}
+
+func TestBinaryTreeMap_JSON_KeepsOrder(t *testing.T) {
+	m := MakeBinaryTreeMap[label, int](func(a, b label) int { return types.StringComparator(string(b), string(a)) })
+	m.Put("a", 1)
+	m.Put("c", 3)
+	m.Put("b", 2)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"c":3,"b":2,"a":1}`, string(data))
+
+	decoded := MakeBinaryTreeMap[label, int](m.comparator)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+
+	assert.Error(t, json.Unmarshal(data, &BinaryTreeMap[label, int]{}))
+}
+
+func TestFlatMap_JSON(t *testing.T) {
+	m := MakeFlatMap[float64, []string](types.Float64Comparator)
+	m.Put(2.5, []string{"x"})
+	m.Put(-1, nil)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"key":-1,"val":null},{"key":2.5,"val":["x"]}]`, string(data))
+
+	decoded := MakeFlatMap[float64, []string](types.Float64Comparator)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+}
+
+func TestSkipListMap_JSON(t *testing.T) {
+	m := MakeSkipListMap[label, int](types.Ordered[label])
+	m.Put("b", 2)
+	m.Put("a", 1)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":2}`, string(data))
+
+	decoded := MakeSkipListMap[label, int](types.Ordered[label])
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+	assert.Error(t, json.Unmarshal(data, &SkipListMap[label, int]{}))
+}
+
+func TestBiMap_JSON(t *testing.T) {
+	m := MakeTreeBiMap[string, int](types.StringComparator, types.IntComparator)
+	m.Put("one", 1)
+	m.Put("two", 2)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"one":1,"two":2}`, string(data))
+
+	decoded := MakeTreeBiMap[string, int](types.StringComparator, types.IntComparator)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	key, ok := decoded.Inverse().Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, "two", key)
+}
+
+func TestBiMap_JSON_DuplicateValues(t *testing.T) {
+	m := MakeTreeBiMap[string, int](types.StringComparator, types.IntComparator)
+	m.Put("kept", 7)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"one": 1, "uno": 1}`), m))
+	assert.Equal(t, []string{"kept"}, m.Keys())
+	assert.True(t, m.ContainsValue(7))
+	assert.False(t, m.ContainsValue(1))
+}
@@ -1,5 +1,7 @@
 package dict
 
+import "utils-generics/collections"
+
 type redBlackTreeNode struct {
 	key    int
 	val    string
@@ -18,46 +20,107 @@ func MakeRedBlackTreeMap() *RedBlackTreeMap {
 }
 
 func (s *RedBlackTreeMap) Put(key int, val string) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
 	if s.root == nil {
 		s.root = &redBlackTreeNode{key: key, val: val, red: false}
 		return
 	}
 
 	node := s.root
-	prev := s.root
 	for {
 		if key < node.key {
 			if node.left == nil {
-				node.left = &redBlackTreeNode{key: key, val: val, red: true, parent: prev}
-				break
+				node.left = &redBlackTreeNode{key: key, val: val, red: true, parent: node}
+				s.balanceFromNode(node.left)
+				return
 			}
-			prev = node
 			node = node.left
 		} else if key > node.key {
 			if node.right == nil {
-				node.right = &redBlackTreeNode{key: key, val: val, red: true, parent: prev}
-				break
+				node.right = &redBlackTreeNode{key: key, val: val, red: true, parent: node}
+				s.balanceFromNode(node.right)
+				return
 			}
-			prev = node
 			node = node.right
 		} else {
-			break
+			node.val = val
+			return
 		}
 	}
-	balanceFromNode(node)
 }
 
-func balanceFromNode(node *redBlackTreeNode) {
-	if node.parent == nil {
-		node.red = false
-		return
+// balanceFromNode restores the red-black rules after the insertion of the red node, recolouring and rotating
+// its ancestors as long as its parent is red as well
+func (s *RedBlackTreeMap) balanceFromNode(node *redBlackTreeNode) {
+	for node.parent != nil && node.parent.red {
+		parent := node.parent
+		grandparent := parent.parent // never nil, as the root is black
+		if parent == grandparent.left {
+			uncle := grandparent.right
+			if uncle != nil && uncle.red {
+				parent.red, uncle.red, grandparent.red = false, false, true
+				node = grandparent
+				continue
+			}
+			if node == parent.right {
+				s.rotateLeftOnRedBlackNode(parent)
+				node, parent = parent, node
+			}
+			parent.red, grandparent.red = false, true
+			s.rotateRightOnRedBlackNode(grandparent)
+		} else {
+			uncle := grandparent.left
+			if uncle != nil && uncle.red {
+				parent.red, uncle.red, grandparent.red = false, false, true
+				node = grandparent
+				continue
+			}
+			if node == parent.left {
+				s.rotateRightOnRedBlackNode(parent)
+				node, parent = parent, node
+			}
+			parent.red, grandparent.red = false, true
+			s.rotateLeftOnRedBlackNode(grandparent)
+		}
+	}
+	s.root.red = false
+}
+
+// rotateRightOnRedBlackNode moves the left child of the node in its place, updating the root if needed
+func (s *RedBlackTreeMap) rotateRightOnRedBlackNode(node *redBlackTreeNode) {
+	left := node.left
+	node.left = left.right
+	if left.right != nil {
+		left.right.parent = node
 	}
+	s.replaceChild(node, left)
+	left.right = node
+	node.parent = left
 }
 
-func rotateRightOnRedBlackNode(node *redBlackTreeNode) {
-	// TODO
+// rotateLeftOnRedBlackNode moves the right child of the node in its place, updating the root if needed
+func (s *RedBlackTreeMap) rotateLeftOnRedBlackNode(node *redBlackTreeNode) {
+	right := node.right
+	node.right = right.left
+	if right.left != nil {
+		right.left.parent = node
+	}
+	s.replaceChild(node, right)
+	right.left = node
+	node.parent = right
 }
 
-func rotateLeftOnRedBlackNode(node *redBlackTreeNode) {
-	// TODO
+// replaceChild links the replacement to the parent of the node, in place of the node
+func (s *RedBlackTreeMap) replaceChild(node, replacement *redBlackTreeNode) {
+	replacement.parent = node.parent
+	if node.parent == nil {
+		s.root = replacement
+	} else if node.parent.left == node {
+		node.parent.left = replacement
+	} else {
+		node.parent.right = replacement
+	}
 }
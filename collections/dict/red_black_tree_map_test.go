@@ -0,0 +1,29 @@
+package dict
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRedBlackTreeMap_PutBalances(t *testing.T) {
+	m := MakeRedBlackTreeMap()
+	m.Put(1, "one")
+	m.Put(2, "two")
+	m.Put(3, "three")
+
+	// the ascending keys would make a chain, the rotation lifts the middle one to the root
+	assert.Equal(t, 2, m.root.key)
+	assert.False(t, m.root.red)
+	assert.Nil(t, m.root.parent)
+	for _, child := range []*redBlackTreeNode{m.root.left, m.root.right} {
+		assert.True(t, child.red)
+		assert.Same(t, m.root, child.parent)
+	}
+	assert.Equal(t, 1, m.root.left.key)
+	assert.Equal(t, 3, m.root.right.key)
+
+	m.Put(2, "deux")
+	assert.Equal(t, "deux", m.root.val)
+	assert.Nil(t, m.root.left.left)
+	assert.Nil(t, m.root.right.right)
+}
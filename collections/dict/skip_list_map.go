@@ -0,0 +1,373 @@
+package dict
+
+import (
+	"math/bits"
+	"math/rand"
+	"utils-generics/collections"
+)
+
+const (
+	// skipListMaxLevel bounds the number of levels of a skip list, enough for 4^32 entries
+	skipListMaxLevel = 32
+)
+
+type skipListNode[K any, T any] struct {
+	Entry[K, T]
+	// next holds the following node on every level the node is part of, the lowest first
+	next []*skipListNode[K, T]
+}
+
+// SkipListMap is a sorted map implementation using a skip list: a sorted linked list of the entries, along with
+// sparser linked lists on top of it that let a search skip over most of the entries.
+//
+// Every entry is part of the lowest list, and of each list above it with a probability of 1/4, so that the lists
+// stay balanced on average whatever the order of the keys, with no rotations. The last entry of every list is kept,
+// so that putting a key greater than all others, e.g. a timestamp in a time series, takes no search at all.
+//
+// The levels are drawn from a random generator, which MakeSkipListMapWithSeed seeds so that the layout of the map,
+// and its performance, are the same in every run.
+//
+// It's performance characteristics are:
+//
+// - Put: O(log n) expected, O(1) expected for a key greater than all others
+//
+// - Get: O(log n) expected
+//
+// - Remove: O(log n) expected
+//
+// - Floor, Ceiling, Lower, Higher: O(log n) expected
+type SkipListMap[K any, T any] struct {
+	// head precedes the first node on every level
+	head *skipListNode[K, T]
+	// tail holds the last node of every level, or the head for the empty ones
+	tail [skipListMaxLevel]*skipListNode[K, T]
+	// level is the number of levels holding nodes
+	level      int
+	size       int
+	comparator func(a, b K) int
+	// rand is the state of the generator of the levels
+	rand uint64
+}
+
+// MakeSkipListMap creates a new SkipListMap, drawing the levels of its entries from a randomly seeded generator
+func MakeSkipListMap[K any, T any](c func(a, b K) int) *SkipListMap[K, T] {
+	return MakeSkipListMapWithSeed[K, T](c, rand.Uint64())
+}
+
+// MakeSkipListMapWithSeed creates a new SkipListMap, drawing the levels of its entries from a generator
+// with the given seed, e.g. to get the same layout in every run of a test
+func MakeSkipListMapWithSeed[K any, T any](c func(a, b K) int, seed uint64) *SkipListMap[K, T] {
+	s := &SkipListMap[K, T]{comparator: c, rand: seed}
+	s.Clear()
+	return s
+}
+
+// randomLevel returns the number of levels of a new node, which is above n with a probability of 1/4^n
+func (s *SkipListMap[K, T]) randomLevel() int {
+	// splitmix64
+	s.rand += 0x9e3779b97f4a7c15
+	z := s.rand
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	z ^= z >> 31
+
+	// every pair of trailing zero bits has a probability of 1/4
+	level := 1 + bits.TrailingZeros64(z)/2
+	if level > skipListMaxLevel {
+		level = skipListMaxLevel
+	}
+	return level
+}
+
+// before returns the last node whose key is less than the key, or less than or equal to it when inclusive,
+// or the head if there is none. When update is not nil, it is filled with the last such node of every level.
+func (s *SkipListMap[K, T]) before(key K, inclusive bool, update *[skipListMaxLevel]*skipListNode[K, T]) *skipListNode[K, T] {
+	bound := 0
+	if inclusive {
+		bound = 1
+	}
+	node := s.head
+	for level := s.level - 1; level >= 0; level-- {
+		for next := node.next[level]; next != nil && s.comparator(next.Key, key) < bound; next = node.next[level] {
+			node = next
+		}
+		if update != nil {
+			update[level] = node
+		}
+	}
+	return node
+}
+
+// find returns the node of the key, or nil if the key is not in the map
+func (s *SkipListMap[K, T]) find(key K) *skipListNode[K, T] {
+	node := s.before(key, false, nil).next[0]
+	if node == nil || s.comparator(node.Key, key) != 0 {
+		return nil
+	}
+	return node
+}
+
+// Put adds a new entry to the map. If the key already exists, its value is overwritten.
+//
+// Time complexity: O(log n) expected, O(1) expected for a key greater than all others
+func (s *SkipListMap[K, T]) Put(key K, val T) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	var update [skipListMaxLevel]*skipListNode[K, T]
+	if s.size > 0 && s.comparator(key, s.tail[0].Key) > 0 {
+		// the new node goes after the last node of every level
+		update = s.tail
+	} else {
+		node := s.before(key, false, &update).next[0]
+		if node != nil && s.comparator(node.Key, key) == 0 {
+			node.Val = val
+			return
+		}
+		for level := s.level; level < skipListMaxLevel; level++ {
+			update[level] = s.head
+		}
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		s.level = level
+	}
+	node := &skipListNode[K, T]{Entry: Entry[K, T]{Key: key, Val: val}, next: make([]*skipListNode[K, T], level)}
+	for i := range node.next {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+		if node.next[i] == nil {
+			s.tail[i] = node
+		}
+	}
+	s.size++
+}
+
+// Remove removes an entry from the map, returning true if the entry was found and removed
+// and false if the entry was not found
+//
+// Time complexity: O(log n) expected
+func (s *SkipListMap[K, T]) Remove(key K) bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	var update [skipListMaxLevel]*skipListNode[K, T]
+	node := s.before(key, false, &update).next[0]
+	if node == nil || s.comparator(node.Key, key) != 0 {
+		return false
+	}
+	s.unlink(node, &update)
+	return true
+}
+
+// unlink removes the node from every level, given the node preceding it on each of them
+func (s *SkipListMap[K, T]) unlink(node *skipListNode[K, T], update *[skipListMaxLevel]*skipListNode[K, T]) {
+	for i, next := range node.next {
+		update[i].next[i] = next
+		if s.tail[i] == node {
+			s.tail[i] = update[i]
+		}
+	}
+	for s.level > 0 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+}
+
+// Get returns the value associated with the provided key and true if the key was found and false if otherwise
+//
+// Time complexity: O(log n) expected
+func (s *SkipListMap[K, T]) Get(key K) (T, bool) {
+	node := s.find(key)
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return node.Val, true
+}
+
+// ContainsKey returns true if the map contains an entry with the provided key and false if otherwise
+func (s *SkipListMap[K, T]) ContainsKey(key K) bool {
+	return s.find(key) != nil
+}
+
+// Size returns the number of entries in the map
+func (s *SkipListMap[K, T]) Size() int {
+	return s.size
+}
+
+// IsEmpty returns true if the map is empty and false if otherwise
+func (s *SkipListMap[K, T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// IsNotEmpty returns true if the map is not empty and false if otherwise
+func (s *SkipListMap[K, T]) IsNotEmpty() bool {
+	return !s.IsEmpty()
+}
+
+// Formatted returns a string representation of the map, in key order
+func (s *SkipListMap[K, T]) Formatted() string {
+	return s.String()
+}
+
+// Clear removes all entries from the map
+func (s *SkipListMap[K, T]) Clear() {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	s.head = &skipListNode[K, T]{next: make([]*skipListNode[K, T], skipListMaxLevel)}
+	for i := range s.tail {
+		s.tail[i] = s.head
+	}
+	s.level, s.size = 0, 0
+}
+
+// ForEach visits the entries in key order until visit returns false
+func (s *SkipListMap[K, T]) ForEach(visit func(key K, val T) bool) {
+	for node := s.head.next[0]; node != nil; node = node.next[0] {
+		if !visit(node.Key, node.Val) {
+			return
+		}
+	}
+}
+
+// Entries returns the entries of the map in key order
+func (s *SkipListMap[K, T]) Entries() []Entry[K, T] {
+	entries := make([]Entry[K, T], 0, s.size)
+	for node := s.head.next[0]; node != nil; node = node.next[0] {
+		entries = append(entries, node.Entry)
+	}
+	return entries
+}
+
+// Keys returns the keys of the map in order
+func (s *SkipListMap[K, T]) Keys() []K {
+	keys := make([]K, 0, s.size)
+	for node := s.head.next[0]; node != nil; node = node.next[0] {
+		keys = append(keys, node.Key)
+	}
+	return keys
+}
+
+// Values returns the values of the map in key order
+func (s *SkipListMap[K, T]) Values() []T {
+	values := make([]T, 0, s.size)
+	for node := s.head.next[0]; node != nil; node = node.next[0] {
+		values = append(values, node.Val)
+	}
+	return values
+}
+
+// ---------------
+// OrderedMap methods
+
+// First returns the first key of the map, or the zero value if the map is empty
+func (s *SkipListMap[K, T]) First() K {
+	if s.size == 0 {
+		var zero K
+		return zero
+	}
+	return s.head.next[0].Key
+}
+
+// Last returns the last key of the map, or the zero value if the map is empty
+func (s *SkipListMap[K, T]) Last() K {
+	if s.size == 0 {
+		var zero K
+		return zero
+	}
+	return s.tail[0].Key
+}
+
+// RemoveFirst removes the first entry of the map, returning false if the map is empty
+//
+// Time complexity: O(1) expected
+func (s *SkipListMap[K, T]) RemoveFirst() bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if s.size == 0 {
+		return false
+	}
+	// the first node follows the head on every level
+	var update [skipListMaxLevel]*skipListNode[K, T]
+	for i := range update {
+		update[i] = s.head
+	}
+	s.unlink(s.head.next[0], &update)
+	return true
+}
+
+// RemoveLast removes the last entry of the map, returning false if the map is empty
+//
+// Time complexity: O(log n) expected
+func (s *SkipListMap[K, T]) RemoveLast() bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if s.size == 0 {
+		return false
+	}
+	var update [skipListMaxLevel]*skipListNode[K, T]
+	last := s.tail[0]
+	s.before(last.Key, false, &update)
+	s.unlink(last, &update)
+	return true
+}
+
+// ----------------
+// NavigableMap methods
+
+// entryOf returns the entry of the node, or false if the node is the head or nil
+func (s *SkipListMap[K, T]) entryOf(node *skipListNode[K, T]) (Entry[K, T], bool) {
+	if node == nil || node == s.head {
+		return Entry[K, T]{}, false
+	}
+	return node.Entry, true
+}
+
+// Floor returns the entry with the greatest key less than or equal to the given key, if any
+//
+// Time complexity: O(log n) expected
+func (s *SkipListMap[K, T]) Floor(key K) (Entry[K, T], bool) {
+	return s.entryOf(s.before(key, true, nil))
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the given key, if any
+//
+// Time complexity: O(log n) expected
+func (s *SkipListMap[K, T]) Ceiling(key K) (Entry[K, T], bool) {
+	return s.entryOf(s.before(key, false, nil).next[0])
+}
+
+// Lower returns the entry with the greatest key strictly less than the given key, if any
+//
+// Time complexity: O(log n) expected
+func (s *SkipListMap[K, T]) Lower(key K) (Entry[K, T], bool) {
+	return s.entryOf(s.before(key, false, nil))
+}
+
+// Higher returns the entry with the smallest key strictly greater than the given key, if any
+//
+// Time complexity: O(log n) expected
+func (s *SkipListMap[K, T]) Higher(key K) (Entry[K, T], bool) {
+	return s.entryOf(s.before(key, true, nil).next[0])
+}
+
+// Range returns, in order, the entries whose keys are in the half-open interval [from, to)
+//
+// Time complexity: O(log n + m) expected where m is the number of entries returned
+func (s *SkipListMap[K, T]) Range(from, to K) []Entry[K, T] {
+	var entries []Entry[K, T]
+	for node := s.before(from, false, nil).next[0]; node != nil && s.comparator(node.Key, to) < 0; node = node.next[0] {
+		entries = append(entries, node.Entry)
+	}
+	return entries
+}
@@ -0,0 +1,176 @@
+package dict
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestSkipListMap_Put(t *testing.T) {
+	var m Map[int, string] = MakeSkipListMap[int, string](types.IntComparator)
+	m.Put(3, "three")
+	m.Put(1, "one")
+	m.Put(2, "two")
+	m.Put(1, "uno")
+
+	assert.Equal(t, 3, m.Size())
+	assert.Equal(t, []int{1, 2, 3}, m.Keys())
+	assert.Equal(t, []string{"uno", "two", "three"}, m.Values())
+	val, ok := m.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "uno", val)
+	_, ok = m.Get(4)
+	assert.False(t, ok)
+}
+
+func TestSkipListMap_RandomOrder(t *testing.T) {
+	m := MakeSkipListMapWithSeed[int, int](types.IntComparator, 1)
+	r := rand.New(rand.NewSource(1))
+	keys := r.Perm(1000)
+	for _, key := range keys {
+		m.Put(key, -key)
+	}
+	assert.NoError(t, m.Validate())
+
+	for _, key := range keys[:500] {
+		assert.True(t, m.Remove(key))
+	}
+	assert.False(t, m.Remove(keys[0]))
+	assert.NoError(t, m.Validate())
+
+	rest := append([]int(nil), keys[500:]...)
+	sort.Ints(rest)
+	assert.Equal(t, rest, m.Keys())
+	for _, key := range rest {
+		val, ok := m.Get(key)
+		assert.True(t, ok)
+		assert.Equal(t, -key, val)
+	}
+}
+
+func TestSkipListMap_Append(t *testing.T) {
+	// time series keys only ever grow, and are appended after the last node without a search
+	m := MakeSkipListMapWithSeed[int, int](types.IntComparator, 1)
+	for ts := 0; ts < 1000; ts += 10 {
+		m.Put(ts, ts)
+	}
+	m.Put(995, 995)
+	m.Put(990, -990)
+
+	assert.NoError(t, m.Validate())
+	assert.Equal(t, 101, m.Size())
+	assert.Equal(t, 995, m.Last())
+	val, _ := m.Get(990)
+	assert.Equal(t, -990, val)
+}
+
+func TestSkipListMap_Seed(t *testing.T) {
+	levels := func(seed uint64) []int {
+		m := MakeSkipListMapWithSeed[int, int](types.IntComparator, seed)
+		for i := 0; i < 100; i++ {
+			m.Put(i, i)
+		}
+		var levels []int
+		for node := m.head.next[0]; node != nil; node = node.next[0] {
+			levels = append(levels, len(node.next))
+		}
+		return levels
+	}
+
+	assert.Equal(t, levels(42), levels(42))
+	assert.NotEqual(t, levels(42), levels(43))
+}
+
+func TestSkipListMap_Ordered(t *testing.T) {
+	var m OrderedMap[int, string] = MakeSkipListMap[int, string](types.IntComparator)
+	assert.False(t, m.RemoveFirst())
+	assert.False(t, m.RemoveLast())
+	assert.Equal(t, 0, m.First())
+
+	for _, key := range []int{5, 1, 9, 3, 7} {
+		m.Put(key, "")
+	}
+
+	assert.Equal(t, 1, m.First())
+	assert.Equal(t, 9, m.Last())
+	assert.True(t, m.RemoveFirst())
+	assert.True(t, m.RemoveLast())
+	assert.Equal(t, []int{3, 5, 7}, m.Keys())
+	assert.Equal(t, 3, m.First())
+	assert.Equal(t, 7, m.Last())
+	assert.NoError(t, m.(*SkipListMap[int, string]).Validate())
+}
+
+func TestSkipListMap_Navigable(t *testing.T) {
+	var m NavigableMap[int, string] = MakeSkipListMap[int, string](types.IntComparator)
+	m.Put(10, "ten")
+	m.Put(20, "twenty")
+	m.Put(30, "thirty")
+
+	entry, ok := m.Floor(15)
+	assert.True(t, ok)
+	assert.Equal(t, Entry[int, string]{Key: 10, Val: "ten"}, entry)
+
+	entry, ok = m.Floor(20)
+	assert.True(t, ok)
+	assert.Equal(t, 20, entry.Key)
+
+	_, ok = m.Floor(5)
+	assert.False(t, ok)
+
+	entry, ok = m.Ceiling(15)
+	assert.True(t, ok)
+	assert.Equal(t, 20, entry.Key)
+
+	_, ok = m.Ceiling(31)
+	assert.False(t, ok)
+
+	_, ok = m.Lower(10)
+	assert.False(t, ok)
+
+	entry, ok = m.Lower(30)
+	assert.True(t, ok)
+	assert.Equal(t, 20, entry.Key)
+
+	entry, ok = m.Higher(10)
+	assert.True(t, ok)
+	assert.Equal(t, 20, entry.Key)
+
+	_, ok = m.Higher(30)
+	assert.False(t, ok)
+
+	assert.Equal(t, []Entry[int, string]{{Key: 10, Val: "ten"}, {Key: 20, Val: "twenty"}}, m.Range(0, 30))
+	assert.Equal(t, []Entry[int, string]{{Key: 20, Val: "twenty"}}, m.Range(11, 21))
+	assert.Empty(t, m.Range(31, 40))
+}
+
+func TestSkipListMap_Clear(t *testing.T) {
+	m := MakeSkipListMap[int, int](types.IntComparator)
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+	}
+
+	m.Clear()
+
+	assert.True(t, m.IsEmpty())
+	assert.Empty(t, m.Keys())
+	m.Put(1, 1)
+	assert.True(t, m.IsNotEmpty())
+	assert.NoError(t, m.Validate())
+}
+
+func TestSkipListMap_ForEach(t *testing.T) {
+	m := MakeSkipListMap[int, int](types.IntComparator)
+	for _, key := range []int{4, 2, 8, 6} {
+		m.Put(key, key)
+	}
+
+	var keys []int
+	m.ForEach(func(key, _ int) bool {
+		keys = append(keys, key)
+		return key < 6
+	})
+	assert.Equal(t, []int{2, 4, 6}, keys)
+}
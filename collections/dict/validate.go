@@ -0,0 +1,229 @@
+package dict
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: validate.go
+// Checking of the internal invariants of the maps, see collections.Validator.
+
+// Validate checks that every entry sits in the bucket of its key and that no key is stored twice
+func (s *HashMap[K, T]) Validate() error {
+	for i, node := range s.table {
+		for ; node != nil; node = node.next {
+			if bucket := s.bucket(node.Key); bucket != uint64(i) {
+				return fmt.Errorf("dict: HashMap key %v is in bucket %d instead of bucket %d", node.Key, i, bucket)
+			}
+			for other := node.next; other != nil; other = other.next {
+				if s.equals(node.Key, other.Key) {
+					return fmt.Errorf("dict: HashMap key %v is stored twice", node.Key)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks that the keys of the tree are in strictly increasing order from left to right
+func (s *BinaryTreeMap[K, T]) Validate() error {
+	var prev *binaryTreeNode[K, T]
+	var err error
+	transverse(s.root, func(node *binaryTreeNode[K, T]) {
+		if err == nil && prev != nil && s.comparator(prev.Key, node.Key) >= 0 {
+			err = fmt.Errorf("dict: BinaryTreeMap key %v is not ordered after key %v", node.Key, prev.Key)
+		}
+		prev = node
+	})
+	return err
+}
+
+// Validate checks the ordering of the keys, the parent links and the red-black rules:
+// the root is black, no red node has a red child and every path from the root down to a leaf
+// goes through the same number of black nodes
+func (s *RedBlackTreeMap) Validate() error {
+	if s.root == nil {
+		return nil
+	}
+	if s.root.red {
+		return fmt.Errorf("dict: RedBlackTreeMap root %d is red", s.root.key)
+	}
+	if s.root.parent != nil {
+		return fmt.Errorf("dict: RedBlackTreeMap root %d has a parent", s.root.key)
+	}
+	_, err := validateRedBlackNode(s.root, nil, nil)
+	return err
+}
+
+// validateRedBlackNode checks the subtree, whose keys must lie strictly between min and max when they are not nil,
+// and returns its black height
+func validateRedBlackNode(node *redBlackTreeNode, min, max *int) (int, error) {
+	if node == nil {
+		return 1, nil
+	}
+	if (min != nil && node.key <= *min) || (max != nil && node.key >= *max) {
+		return 0, fmt.Errorf("dict: RedBlackTreeMap key %d is out of order", node.key)
+	}
+
+	for _, child := range []*redBlackTreeNode{node.left, node.right} {
+		if child == nil {
+			continue
+		}
+		if child.parent != node {
+			return 0, fmt.Errorf("dict: RedBlackTreeMap node %d does not point back to its parent %d", child.key, node.key)
+		}
+		if node.red && child.red {
+			return 0, fmt.Errorf("dict: RedBlackTreeMap red node %d has a red child %d", node.key, child.key)
+		}
+	}
+
+	left, err := validateRedBlackNode(node.left, min, &node.key)
+	if err != nil {
+		return 0, err
+	}
+	right, err := validateRedBlackNode(node.right, &node.key, max)
+	if err != nil {
+		return 0, err
+	}
+	if left != right {
+		return 0, fmt.Errorf("dict: RedBlackTreeMap node %d has black height %d on the left and %d on the right", node.key, left, right)
+	}
+	if node.red {
+		return left, nil
+	}
+	return left + 1, nil
+}
+
+// Validate checks that the entries are sorted by strictly increasing keys
+func (s *FlatMap[K, T]) Validate() error {
+	return checkSorted(s.array, s.comparator)
+}
+
+// Validate checks that the entries laid out in Eytzinger order are sorted by strictly increasing keys
+// when read in order
+func (s *FrozenSortedMap[K, T]) Validate() error {
+	if len(s.keys) == 0 || len(s.keys) != len(s.values) {
+		return fmt.Errorf("dict: FrozenSortedMap has %d keys for %d values", len(s.keys), len(s.values))
+	}
+	return checkSorted(s.Entries(), s.comparator)
+}
+
+// Validate checks that every key is found in its own slot, with a single probe
+func (s *FrozenHashMap[K, T]) Validate() error {
+	if len(s.keys) != len(s.values) {
+		return fmt.Errorf("dict: FrozenHashMap has %d keys for %d values", len(s.keys), len(s.values))
+	}
+	for slot, key := range s.keys {
+		if found := s.lookup(key); found != slot {
+			return fmt.Errorf("dict: FrozenHashMap key %v is in slot %d but is looked up in slot %d", key, slot, found)
+		}
+	}
+	return nil
+}
+
+// Validate checks that every entry records its distance from the slot of its key and the bits of its hash,
+// that no entry is further from its slot than the one it follows would let it be, and that the size is right
+func (s *FlatHashMap[K, T]) Validate() error {
+	if len(s.meta) != len(s.entries) || len(s.meta)&(len(s.meta)-1) != 0 {
+		return fmt.Errorf("dict: FlatHashMap has %d metadata for %d slots", len(s.meta), len(s.entries))
+	}
+	size, mask := 0, len(s.meta)-1
+	for i, meta := range s.meta {
+		if meta.dist == 0 {
+			continue
+		}
+		size++
+		key := s.entries[i].Key
+		hash := s.hash(key)
+		if dist := uint32((i-int(hash&uint64(mask)))&mask) + 1; meta.dist != dist || meta.hash != uint32(hash>>32) {
+			return fmt.Errorf("dict: FlatHashMap key %v is recorded at distance %d instead of %d", key, meta.dist-1, dist-1)
+		}
+		// an entry can only be one slot further from its home than the entry before it
+		if prev := s.meta[(i-1)&mask]; meta.dist > prev.dist+1 {
+			return fmt.Errorf("dict: FlatHashMap key %v is at distance %d after an entry at distance %d", key, meta.dist-1, int(prev.dist)-1)
+		}
+		if found := s.find(key); found != i {
+			return fmt.Errorf("dict: FlatHashMap key %v is in slot %d but is found in slot %d", key, i, found)
+		}
+	}
+	if size != s.size {
+		return fmt.Errorf("dict: FlatHashMap holds %d entries but has a size of %d", size, s.size)
+	}
+	return nil
+}
+
+// Validate checks that every level is sorted by strictly increasing keys and holds the nodes of the level below
+// that are tall enough, that the last node and the number of levels are up to date, and that the size is right
+func (s *SkipListMap[K, T]) Validate() error {
+	var lower []*skipListNode[K, T]
+	for level := 0; level < skipListMaxLevel; level++ {
+		if (level < s.level) != (s.head.next[level] != nil) {
+			return fmt.Errorf("dict: SkipListMap has %d levels but level %d is empty", s.level, level)
+		}
+
+		// the nodes of the level, taken from the level below, or the head on the lowest level
+		var nodes []*skipListNode[K, T]
+		for _, node := range lower {
+			if len(node.next) > level {
+				nodes = append(nodes, node)
+			}
+		}
+		last := s.head
+		for i, node := 0, s.head.next[level]; node != nil; i, node = i+1, node.next[level] {
+			if len(node.next) <= level {
+				return fmt.Errorf("dict: SkipListMap key %v is on level %d above its %d levels", node.Key, level, len(node.next))
+			}
+			if last != s.head && s.comparator(last.Key, node.Key) >= 0 {
+				return fmt.Errorf("dict: SkipListMap keys %v and %v are not in strictly increasing order on level %d", last.Key, node.Key, level)
+			}
+			if level > 0 && (i >= len(nodes) || nodes[i] != node) {
+				return fmt.Errorf("dict: SkipListMap key %v is on level %d but not on the level below", node.Key, level)
+			}
+			if level == 0 {
+				nodes = append(nodes, node)
+			}
+			last = node
+		}
+		if level > 0 && len(nodes) != countLevel(s.head, level) {
+			return fmt.Errorf("dict: SkipListMap level %d misses some nodes of %d levels or more", level, level+1)
+		}
+		if s.tail[level] != last {
+			return fmt.Errorf("dict: SkipListMap does not keep the last node of level %d", level)
+		}
+		if level == 0 && len(nodes) != s.size {
+			return fmt.Errorf("dict: SkipListMap holds %d entries but has a size of %d", len(nodes), s.size)
+		}
+		lower = nodes
+	}
+	return nil
+}
+
+func countLevel[K any, T any](head *skipListNode[K, T], level int) int {
+	count := 0
+	for node := head.next[level]; node != nil; node = node.next[level] {
+		count++
+	}
+	return count
+}
+
+// Validate checks that the key to value and the value to key mappings mirror each other,
+// along with the invariants of the underlying maps
+func (s *BiMap[K, V]) Validate() error {
+	for _, m := range []any{s.forward, s.backward} {
+		if v, ok := m.(collections.Validator); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.forward.Size() != s.backward.Size() {
+		return fmt.Errorf("dict: BiMap has %d keys but %d values", s.forward.Size(), s.backward.Size())
+	}
+	for _, entry := range s.forward.Entries() {
+		if key, ok := s.backward.Get(entry.Val); !ok || !s.keyEquals(key, entry.Key) {
+			return fmt.Errorf("dict: BiMap value %v of key %v does not map back to it", entry.Val, entry.Key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package dict
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestBinaryTreeMap_Validate(t *testing.T) {
+	m := MakeBinaryTreeMap[int, int](types.IntComparator)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		switch r.Intn(4) {
+		case 0:
+			m.Remove(r.Intn(100))
+		case 1:
+			m.RemoveFirst()
+		default:
+			m.Put(r.Intn(100), i)
+		}
+		assert.NoError(t, m.Validate())
+	}
+
+	m.root.left, m.root.right = m.root.right, m.root.left
+	assert.Error(t, m.Validate())
+}
+
+func TestBinaryTreeMap_RemoveFirstAndLastOnEmptyMap(t *testing.T) {
+	m := MakeBinaryTreeMap[int, int](types.IntComparator)
+
+	assert.False(t, m.RemoveFirst())
+	assert.False(t, m.RemoveLast())
+
+	m.Put(1, 1)
+	assert.True(t, m.RemoveLast())
+	assert.False(t, m.RemoveLast())
+	assert.True(t, m.IsEmpty())
+}
+
+func TestBinaryTreeMap_Rotations(t *testing.T) {
+	m := MakeBinaryTreeMap[int, int](types.IntComparator)
+	for _, key := range []int{2, 1, 3} {
+		m.Put(key, key)
+	}
+
+	m.RightRotation()
+	first, _ := m.First()
+	assert.Equal(t, 1, m.root.Key)
+	assert.Equal(t, 1, first)
+	assert.Equal(t, []int{1, 2, 3}, m.Keys())
+	assert.NoError(t, m.Validate())
+
+	m.RightRotation() // the root has no left child anymore
+	m.LeftRotation()
+	m.LeftRotation()
+	assert.Equal(t, 3, m.root.Key)
+	assert.Equal(t, []int{1, 2, 3}, m.Keys())
+}
+
+func TestRedBlackTreeMap_Validate(t *testing.T) {
+	m := MakeRedBlackTreeMap()
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		m.Put(r.Intn(500), "")
+		assert.NoError(t, m.Validate())
+	}
+	for i := 0; i < 100; i++ {
+		// ascending keys are the worst case of an unbalanced tree
+		m.Put(1000+i, "")
+	}
+	assert.NoError(t, m.Validate())
+	assert.LessOrEqual(t, redBlackHeight(m.root), 2*11)
+
+	m.root.red = true
+	assert.ErrorContains(t, m.Validate(), "is red")
+}
+
+func redBlackHeight(node *redBlackTreeNode) int {
+	if node == nil {
+		return 0
+	}
+	l, r := redBlackHeight(node.left), redBlackHeight(node.right)
+	if l > r {
+		return l + 1
+	}
+	return r + 1
+}
+
+func TestRedBlackTreeMap_ValidateColours(t *testing.T) {
+	m := MakeRedBlackTreeMap()
+	for _, key := range []int{2, 1, 3, 4} {
+		m.Put(key, "")
+	}
+	assert.NoError(t, m.Validate())
+
+	// 4 is the red child of 3, which is black
+	m.root.right.red = true
+	assert.EqualError(t, m.Validate(), "dict: RedBlackTreeMap red node 3 has a red child 4")
+
+	m.root.right.red = false
+	m.root.right.right.red = false
+	assert.EqualError(t, m.Validate(), "dict: RedBlackTreeMap node 3 has black height 1 on the left and 2 on the right")
+}
+
+func TestHashMap_Validate(t *testing.T) {
+	m := MakeComparableHashMap[int, int](func(key int) int { return key })
+	for i := 0; i < 300; i++ {
+		m.Put(i, i)
+	}
+	assert.NoError(t, m.Validate())
+
+	m.table[0], m.table[1] = m.table[1], m.table[0]
+	assert.EqualError(t, m.Validate(), "dict: HashMap key 1 is in bucket 0 instead of bucket 1")
+
+	m.table[0], m.table[1] = m.table[1], m.table[0]
+	m.table[0].next.Key = 0
+	assert.EqualError(t, m.Validate(), "dict: HashMap key 0 is stored twice")
+}
+
+func TestFlatHashMap_Validate(t *testing.T) {
+	m := MakeComparableFlatHashMap[int, int](identityHash)
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+	}
+	assert.NoError(t, m.Validate())
+
+	m.size++
+	assert.EqualError(t, m.Validate(), "dict: FlatHashMap holds 100 entries but has a size of 101")
+	m.size--
+
+	i := m.find(7)
+	m.meta[i].dist++
+	assert.Error(t, m.Validate())
+	m.meta[i].dist--
+
+	j := m.find(8)
+	m.entries[i], m.entries[j] = m.entries[j], m.entries[i]
+	assert.Error(t, m.Validate())
+}
+
+func TestSkipListMap_Validate(t *testing.T) {
+	m := MakeSkipListMapWithSeed[int, int](types.IntComparator, 1)
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+	}
+	assert.NoError(t, m.Validate())
+
+	m.size++
+	assert.EqualError(t, m.Validate(), "dict: SkipListMap holds 100 entries but has a size of 101")
+	m.size--
+
+	first, second := m.head.next[0], m.head.next[0].next[0]
+	first.Key, second.Key = second.Key, first.Key
+	assert.EqualError(t, m.Validate(), "dict: SkipListMap keys 1 and 0 are not in strictly increasing order on level 0")
+	first.Key, second.Key = second.Key, first.Key
+
+	m.tail[0] = first
+	assert.EqualError(t, m.Validate(), "dict: SkipListMap does not keep the last node of level 0")
+}
+
+func TestFlatMap_Validate(t *testing.T) {
+	m := MakeFlatMap[int, int](types.IntComparator)
+	for _, key := range []int{3, 1, 2, 1, 3} {
+		m.Put(key, key)
+	}
+	assert.NoError(t, m.Validate())
+
+	m.array[0], m.array[1] = m.array[1], m.array[0]
+	assert.Error(t, m.Validate())
+}
+
+func TestFlatMap_PutExistingKeyReplacesTheValue(t *testing.T) {
+	m := MakeFlatMap[string, int](types.StringComparator)
+	m.Put("b", 1)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	assert.Equal(t, 2, m.Size())
+	assert.Equal(t, []string{"a", "b"}, m.Keys())
+	assert.Equal(t, []int{1, 2}, m.Values())
+}
+
+func TestBiMap_Validate(t *testing.T) {
+	m := MakeHashBiMap[string, int](types.StringHash, types.IntHash)
+	m.Put("a", 1)
+	m.ForcePut("b", 1)
+	m.Inverse().Put(2, "c")
+	assert.NoError(t, m.Validate())
+	assert.NoError(t, m.Inverse().Validate())
+
+	m.backward.Put(1, "c")
+	assert.EqualError(t, m.Validate(), "dict: BiMap value 1 of key b does not map back to it")
+
+	m.backward.Remove(1)
+	assert.EqualError(t, m.Validate(), "dict: BiMap has 2 keys but 1 values")
+}
+
+func TestFrozenSortedMap_Validate(t *testing.T) {
+	m := MakeFrozenSortedMap(types.IntComparator, []Entry[int, int]{{1, 1}, {2, 2}, {3, 3}})
+	assert.NoError(t, m.Validate())
+
+	m.keys[1], m.keys[2] = m.keys[2], m.keys[1]
+	assert.Error(t, m.Validate())
+}
+
+func TestFrozenHashMap_Validate(t *testing.T) {
+	source := MakeDefaultHashMap[int, int]()
+	for i := 0; i < 100; i++ {
+		source.Put(i, i)
+	}
+	m, err := MakeDefaultFrozenHashMap[int, int](source)
+	assert.NoError(t, err)
+
+	m.keys[0], m.keys[1] = m.keys[1], m.keys[0]
+	assert.Error(t, m.Validate())
+}
@@ -0,0 +1,112 @@
+package collections
+
+import (
+	"strconv"
+	"strings"
+)
+
+// File: dot.go
+// Graphviz DOT export of the internal structure of the collections, meant for debugging.
+
+// DOTGraph builds a Graphviz DOT digraph.
+//
+// Nodes and edges are written in the order they are added, so a collection that adds them in a deterministic
+// order, e.g. sorting the children of a node, always renders to the same document.
+type DOTGraph struct {
+	b     strings.Builder
+	nodes int
+}
+
+// MakeDOTGraph creates a new DOTGraph with the given name and graph attributes, e.g. "rankdir=LR"
+func MakeDOTGraph(name string, attrs ...string) *DOTGraph {
+	g := &DOTGraph{}
+	g.b.WriteString("digraph ")
+	g.b.WriteString(name)
+	g.b.WriteString(" {\n")
+	for _, attr := range attrs {
+		g.b.WriteString("\t")
+		g.b.WriteString(attr)
+		g.b.WriteString(";\n")
+	}
+	return g
+}
+
+// Node adds a node with the given label and attributes, e.g. `shape=box`, and returns its id
+func (g *DOTGraph) Node(label string, attrs ...string) string {
+	id := "n" + strconv.Itoa(g.nodes)
+	g.nodes++
+	g.b.WriteString("\t")
+	g.b.WriteString(id)
+	g.b.WriteString(" [label=")
+	g.b.WriteString(QuoteDOT(label))
+	for _, attr := range attrs {
+		g.b.WriteString(", ")
+		g.b.WriteString(attr)
+	}
+	g.b.WriteString("];\n")
+	return id
+}
+
+// Edge adds an edge between two nodes with the given attributes
+func (g *DOTGraph) Edge(from, to string, attrs ...string) {
+	g.b.WriteString("\t")
+	g.b.WriteString(from)
+	g.b.WriteString(" -> ")
+	g.b.WriteString(to)
+	if len(attrs) > 0 {
+		g.b.WriteString(" [")
+		g.b.WriteString(strings.Join(attrs, ", "))
+		g.b.WriteString("]")
+	}
+	g.b.WriteString(";\n")
+}
+
+// String returns the DOT document
+func (g *DOTGraph) String() string {
+	return g.b.String() + "}\n"
+}
+
+// AddBinaryTreeDOT adds the nodes of a binary tree to g in pre-order and returns the id of the root, or an empty
+// string if the tree is empty.
+//
+// children returns the left and right children of a node, and node its label and attributes. A node with a single
+// child gets an invisible placeholder for the missing one, so every child is drawn on its own side.
+func AddBinaryTreeDOT[N any](g *DOTGraph, root *N, children func(*N) (*N, *N), node func(*N) (string, []string)) string {
+	if root == nil {
+		return ""
+	}
+
+	label, attrs := node(root)
+	id := g.Node(label, attrs...)
+	left, right := children(root)
+	if left == nil && right == nil {
+		return id
+	}
+	for _, child := range []*N{left, right} {
+		if child == nil {
+			g.Edge(id, g.Node("", "style=invis"), "style=invis")
+			continue
+		}
+		g.Edge(id, AddBinaryTreeDOT(g, child, children, node))
+	}
+	return id
+}
+
+// QuoteDOT returns s as a quoted DOT string
+func QuoteDOT(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type dotNode struct {
+	label       string
+	left, right *dotNode
+}
+
+func TestDOTGraph(t *testing.T) {
+	g := MakeDOTGraph("G", "rankdir=LR")
+	a := g.Node("a")
+	b := g.Node("b", "shape=box")
+	g.Edge(a, b, "label=next", "style=dashed")
+
+	assert.Equal(t, "digraph G {\n\trankdir=LR;\n\tn0 [label=\"a\"];\n\tn1 [label=\"b\", shape=box];\n"+
+		"\tn0 -> n1 [label=next, style=dashed];\n}\n", g.String())
+}
+
+func TestQuoteDOT(t *testing.T) {
+	assert.Equal(t, `"a"`, QuoteDOT("a"))
+	assert.Equal(t, `"say \"hi\"\n\\o/"`, QuoteDOT("say \"hi\"\n\\o/"))
+	assert.Equal(t, `""`, QuoteDOT(""))
+}
+
+func TestAddBinaryTreeDOT(t *testing.T) {
+	root := &dotNode{label: "2", left: &dotNode{label: "1"}, right: &dotNode{label: "4", left: &dotNode{label: "3"}}}
+	g := MakeDOTGraph("T")
+	id := AddBinaryTreeDOT(g, root,
+		func(n *dotNode) (*dotNode, *dotNode) { return n.left, n.right },
+		func(n *dotNode) (string, []string) { return n.label, nil })
+
+	assert.Equal(t, "n0", id)
+	assert.Equal(t, `digraph T {
+	n0 [label="2"];
+	n1 [label="1"];
+	n0 -> n1;
+	n2 [label="4"];
+	n3 [label="3"];
+	n2 -> n3;
+	n4 [label="", style=invis];
+	n2 -> n4 [style=invis];
+	n0 -> n2;
+}
+`, g.String())
+	assert.Empty(t, AddBinaryTreeDOT(MakeDOTGraph("T"), (*dotNode)(nil), nil, nil))
+}
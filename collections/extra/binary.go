@@ -0,0 +1,26 @@
+package extra
+
+import "utils-generics/collections/codec"
+
+// MarshalBinary encodes the words of the trie in order, in the Values layout of the codec package
+func (t *Trie) MarshalBinary() ([]byte, error) {
+	var words []string
+	if t.root != nil {
+		words = t.Suggestions("")
+	}
+	return codec.EncodeValues(words, codec.String[string]())
+}
+
+// UnmarshalBinary replaces the words of the trie with the decoded ones
+func (t *Trie) UnmarshalBinary(data []byte) error {
+	words, err := codec.DecodeValues(data, codec.String[string]())
+	if err != nil {
+		return err
+	}
+
+	*t = *MakeTrie()
+	for _, word := range words {
+		t.Add(word)
+	}
+	return nil
+}
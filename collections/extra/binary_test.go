@@ -0,0 +1,21 @@
+package extra
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTrie_Binary(t *testing.T) {
+	trie := MakeTrie()
+	trie.Add("car")
+	trie.Add("cart")
+
+	data, err := trie.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded Trie
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.True(t, decoded.Contains("car"))
+	assert.True(t, decoded.Contains("cart"))
+	assert.False(t, decoded.Contains("ca"))
+}
@@ -0,0 +1,29 @@
+package extra
+
+import "utils-generics/collections"
+
+// File: dot.go
+// Graphviz DOT export of the trie, see collections.DOTGraph.
+
+// ToDOT returns the nodes of the trie as a Graphviz DOT digraph, with the children of every node sorted by rune
+// and the nodes ending a word drawn as double circles
+func (t *Trie) ToDOT() string {
+	g := collections.MakeDOTGraph("Trie", "node [shape=circle]")
+	if t.root != nil {
+		addTrieNodeDOT(g, t.root)
+	}
+	return g.String()
+}
+
+// addTrieNodeDOT adds the node and its descendants to g and returns the id of the node
+func addTrieNodeDOT(g *collections.DOTGraph, node *trieNode) string {
+	var attrs []string
+	if node.isWord {
+		attrs = append(attrs, "shape=doublecircle")
+	}
+	id := g.Node(string(node.val), attrs...)
+	for _, child := range sortedChildren(node) {
+		g.Edge(id, addTrieNodeDOT(g, child))
+	}
+	return id
+}
@@ -0,0 +1,41 @@
+package extra
+
+import (
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func assertGolden(t *testing.T, name string, actual string) {
+	golden := filepath.Join("testdata", name+".dot.golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(actual), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(expected), actual)
+}
+
+func TestTrie_ToDOT(t *testing.T) {
+	trie := MakeTrie()
+	for _, word := range []string{"tea", "ten", "to", "t", "in", "inn"} {
+		trie.Add(word)
+	}
+
+	// the children are sorted, so the document is the same whatever the order of the map iteration
+	for i := 0; i < 10; i++ {
+		assertGolden(t, "trie", trie.ToDOT())
+	}
+}
@@ -0,0 +1,30 @@
+package extra
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// String returns the words of the trie in order, as printed by the %v verb
+func (t *Trie) String() string {
+	return fmt.Sprint(t)
+}
+
+// Format implements fmt.Formatter, printing the words of the trie in order as a set,
+// see collections.Format for the supported verbs and flags
+func (t *Trie) Format(f fmt.State, verb rune) {
+	var words []string
+	if t.root != nil {
+		words = t.Suggestions("")
+	}
+	collections.Format(f, verb, t, collections.Contents{Size: len(words), Open: "{", Close: "}",
+		ValType: collections.TypeOf[string](),
+		Each: func(visit func(key, val any) bool) {
+			for _, word := range words {
+				if !visit(nil, word) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,17 @@
+package extra
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTrie_Format(t *testing.T) {
+	trie := MakeTrie()
+	trie.Add("car")
+	trie.Add("cat")
+	trie.Add("ant")
+
+	assert.Equal(t, "{ant, car, cat}", trie.String())
+	assert.Equal(t, `{"ant", "car", "cat"}`, fmt.Sprintf("%q", trie))
+}
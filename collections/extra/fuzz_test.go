@@ -0,0 +1,70 @@
+package extra
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+// trieAlphabet holds the letters of the fuzzed words, a multi-byte rune included
+var trieAlphabet = []rune{'a', 'b', 'c', 'é'}
+
+// FuzzTrie decodes the fuzz input into operations on words of up to 3 letters: an operation byte, whose low bits hold
+// the length of the word, followed by a byte per letter. The operations are run against the trie and a builtin map,
+// failing as soon as they disagree or the trie breaks one of its invariants.
+func FuzzTrie(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		trie := MakeTrie()
+		// model holds every word added, true until it is removed: removed words leave their letters behind,
+		// so Starts still matches their prefixes
+		model := map[string]bool{}
+		for i := 0; i < len(data); {
+			code := data[i]
+			i++
+			var word strings.Builder
+			for n := int(code % 4); n > 0 && i < len(data); n-- {
+				word.WriteRune(trieAlphabet[int(data[i])%len(trieAlphabet)])
+				i++
+			}
+			w := word.String()
+
+			switch (code / 4) % 4 {
+			case 0:
+				trie.Add(w)
+				model[w] = true
+			case 1:
+				trie.Remove(w)
+				if _, ok := model[w]; ok {
+					model[w] = false
+				}
+			case 2:
+				if trie.Contains(w) != model[w] {
+					t.Fatalf("Contains(%q) = %t, expected %t", w, !model[w], model[w])
+				}
+			default:
+				// the root holds the empty prefix even when no word was ever added
+				letters := w == ""
+				expected := []string{}
+				for word, isWord := range model {
+					if strings.HasPrefix(word, w) {
+						letters = true
+						if isWord {
+							expected = append(expected, word)
+						}
+					}
+				}
+				sort.Strings(expected)
+				if starts := trie.Starts(w); starts != letters {
+					t.Fatalf("Starts(%q) = %t, expected %t", w, starts, letters)
+				}
+				if suggestions := trie.Suggestions(w); strings.Join(suggestions, ",") != strings.Join(expected, ",") {
+					t.Fatalf("Suggestions(%q) = %v, expected %v", w, suggestions, expected)
+				}
+			}
+
+			if err := trie.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package extra
+
+import "encoding/json"
+
+// MarshalJSON encodes the words of the trie as a sorted JSON array
+func (t *Trie) MarshalJSON() ([]byte, error) {
+	words := []string{}
+	if t.root != nil {
+		words = append(words, t.Suggestions("")...)
+	}
+	return json.Marshal(words)
+}
+
+// UnmarshalJSON replaces the words of the trie with the ones of a JSON array
+func (t *Trie) UnmarshalJSON(data []byte) error {
+	var words []string
+	if err := json.Unmarshal(data, &words); err != nil {
+		return err
+	}
+
+	*t = *MakeTrie()
+	for _, word := range words {
+		t.Add(word)
+	}
+	return nil
+}
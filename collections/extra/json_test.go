@@ -0,0 +1,24 @@
+package extra
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTrie_JSON(t *testing.T) {
+	trie := MakeTrie()
+	trie.Add("help")
+	trie.Add("hello")
+	trie.Add("ant")
+
+	data, err := json.Marshal(trie)
+	assert.NoError(t, err)
+	assert.Equal(t, `["ant","hello","help"]`, string(data))
+
+	var decoded Trie
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded.Contains("hello"))
+	assert.True(t, decoded.Starts("he"))
+	assert.False(t, decoded.Contains("he"))
+}
@@ -1,5 +1,10 @@
 package extra
 
+import (
+	"sort"
+	"utils-generics/collections"
+)
+
 type trieNode struct {
 	val      rune
 	isWord   bool
@@ -17,6 +22,10 @@ func MakeTrie() *Trie {
 // Add adds a new word to the trie
 // Idempotent is the word already exists
 func (t *Trie) Add(word string) {
+	if collections.Debug {
+		defer collections.MustBeValid(t)
+	}
+
 	node := t.root
 	for _, c := range word {
 		if _, ok := node.children[c]; !ok {
@@ -58,6 +67,10 @@ func (t *Trie) Starts(prefix string) bool {
 
 // Remove removes the word from trie i.e. the sequence of letters remain but the word is no longer considered
 func (t *Trie) Remove(word string) {
+	if collections.Debug {
+		defer collections.MustBeValid(t)
+	}
+
 	node := t.root
 	for _, c := range word {
 		if _, ok := node.children[c]; !ok {
@@ -68,7 +81,7 @@ func (t *Trie) Remove(word string) {
 	node.isWord = false
 }
 
-// Suggestions returns all possible words that start with the given prefix
+// Suggestions returns all possible words that start with the given prefix, in order
 // E.g. 'hel' should return ['hello', 'help'] if both words were added to the trie
 func (t *Trie) Suggestions(prefix string) []string {
 	node := t.root
@@ -91,8 +104,19 @@ func getAllFullWordsStartingFromNode(node *trieNode, runeSequence string) []stri
 	if node.isWord {
 		suggestions = append(suggestions, runeSequence)
 	}
-	for _, child := range node.children {
+	// visit the children in order, so that the suggestions come out sorted
+	for _, child := range sortedChildren(node) {
 		suggestions = append(suggestions, getAllFullWordsStartingFromNode(child, runeSequence+string(child.val))...)
 	}
 	return suggestions
 }
+
+// sortedChildren returns the children of the node sorted by rune
+func sortedChildren(node *trieNode) []*trieNode {
+	children := make([]*trieNode, 0, len(node.children))
+	for _, child := range node.children {
+		children = append(children, child)
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].val < children[j].val })
+	return children
+}
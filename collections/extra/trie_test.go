@@ -80,3 +80,14 @@ func TestTrie_Suggestions(t *testing.T) {
 	suggestions := trie.Suggestions("he")
 	assert.Equal(t, []string{"hello", "help"}, suggestions)
 }
+
+func TestTrie_StartsAfterRemove(t *testing.T) {
+	trie := MakeTrie()
+	trie.Add("hello")
+	trie.Remove("hello")
+
+	// the letters of a removed word remain, only the word itself is gone
+	assert.False(t, trie.Contains("hello"))
+	assert.True(t, trie.Starts("hel"))
+	assert.Empty(t, trie.Suggestions("hel"))
+}
@@ -0,0 +1,29 @@
+package extra
+
+import "fmt"
+
+// File: validate.go
+// Checking of the internal invariants of the trie, see collections.Validator.
+
+// Validate checks that every node is stored under the rune it holds
+func (t *Trie) Validate() error {
+	if t.root == nil {
+		return nil
+	}
+	return validateTrieNode(t.root, "")
+}
+
+func validateTrieNode(node *trieNode, prefix string) error {
+	for r, child := range node.children {
+		if child == nil {
+			return fmt.Errorf("extra: Trie node %q has a nil child for %q", prefix, r)
+		}
+		if child.val != r {
+			return fmt.Errorf("extra: Trie node %q holds %q under %q", prefix, child.val, r)
+		}
+		if err := validateTrieNode(child, prefix+string(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package extra
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTrie_Validate(t *testing.T) {
+	trie := MakeTrie()
+	trie.Add("tea")
+	trie.Add("ten")
+	trie.Remove("tea")
+	assert.NoError(t, trie.Validate())
+
+	trie.root.children['t'].children['x'] = trie.root.children['t'].children['e']
+	assert.EqualError(t, trie.Validate(), `extra: Trie node "t" holds 'e' under 'x'`)
+}
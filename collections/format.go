@@ -0,0 +1,142 @@
+package collections
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Contents describes the elements of a collection for Format
+type Contents struct {
+	// Size is the number of elements of the collection
+	Size int
+	// Open and Close enclose the elements, e.g. "[" and "]" for lists and "{" and "}" for sets and maps
+	Open, Close string
+	// Keyed is true for maps, whose elements are printed as key: val
+	Keyed bool
+	// KeyType and ValType are the types of the keys and values of a keyed collection, or ValType alone the type
+	// of the elements of any other, which %#v prints the literal of; see TypeOf. They default to any.
+	KeyType, ValType reflect.Type
+	// Each visits the elements in the order they are printed, with a nil key if the collection is not keyed,
+	// and stops as soon as visit returns false
+	Each func(visit func(key, val any) bool)
+}
+
+// TypeOf returns the type T, an interface type included, for the KeyType and ValType of Contents
+func TypeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// literalType returns the type of the Go literal %#v prints the contents as: a map for keyed collections,
+// or a slice of key and value pairs when the keys cannot be map keys, and a slice for any other collection
+func (c Contents) literalType() reflect.Type {
+	anyType := TypeOf[any]()
+	val := c.ValType
+	if val == nil {
+		val = anyType
+	}
+	if !c.Keyed {
+		return reflect.SliceOf(val)
+	}
+	key := c.KeyType
+	if key == nil {
+		key = anyType
+	}
+	if key.Comparable() {
+		return reflect.MapOf(key, val)
+	}
+	return reflect.SliceOf(reflect.StructOf([]reflect.StructField{{Name: "Key", Type: key}, {Name: "Val", Type: val}}))
+}
+
+// Format prints a collection for fmt, and is meant to implement fmt.Formatter:
+//
+//	%v    the elements, e.g. [1, 2] for lists, {1, 2} for sets and {a: 1, b: 2} for maps
+//	%+v   the type and size of the collection followed by its elements, e.g. list.LinkedList[int] size=2 [1, 2]
+//	%#v   a Go literal holding the elements, e.g. []int{1, 2} for lists and sets and map[string]int{"a": 1} for maps
+//	%.3v  at most 3 elements followed by the number of elements left out, e.g. [1, 2, 3, ... 97 more]
+//
+// Any other verb is applied to each element along with its flags, width and precision, like fmt does for slices.
+func Format(f fmt.State, verb rune, collection any, contents Contents) {
+	limit, truncated := f.Precision()
+	if verb != 'v' {
+		truncated = false
+	}
+	elementFormat := elementFormat(f, verb)
+
+	var b strings.Builder
+	goSyntax := verb == 'v' && f.Flag('#')
+	literal := contents.literalType()
+	// keys that cannot be map keys are printed as the fields of a struct literal
+	pairs := goSyntax && literal.Kind() == reflect.Slice && contents.Keyed
+	open, close := contents.Open, contents.Close
+	switch {
+	case goSyntax:
+		b.WriteString(literal.String())
+		open, close = "{", "}"
+	case verb == 'v' && f.Flag('+'):
+		b.WriteString(strings.TrimPrefix(fmt.Sprintf("%T", collection), "*"))
+		b.WriteString(" size=")
+		b.WriteString(strconv.Itoa(contents.Size))
+		b.WriteByte(' ')
+	}
+
+	b.WriteString(open)
+	printed := 0
+	contents.Each(func(key, val any) bool {
+		if truncated && printed == limit {
+			return false
+		}
+		if printed > 0 {
+			b.WriteString(", ")
+		}
+		switch {
+		case pairs:
+			fmt.Fprintf(&b, "{Key: "+elementFormat+", Val: "+elementFormat+"}", key, val)
+		case contents.Keyed:
+			fmt.Fprintf(&b, elementFormat+": "+elementFormat, key, val)
+		default:
+			fmt.Fprintf(&b, elementFormat, val)
+		}
+		printed++
+		return true
+	})
+	if printed < contents.Size {
+		if printed > 0 {
+			b.WriteString(", ")
+		}
+		if goSyntax {
+			// a comment, so that the literal still compiles
+			fmt.Fprintf(&b, "/* %d more */", contents.Size-printed)
+		} else {
+			fmt.Fprintf(&b, "... %d more", contents.Size-printed)
+		}
+	}
+	b.WriteString(close)
+
+	_, _ = f.Write([]byte(b.String()))
+}
+
+// elementFormat returns the format string of the elements for the verb the collection is printed with
+func elementFormat(f fmt.State, verb rune) string {
+	if verb == 'v' {
+		if f.Flag('#') {
+			return "%#v"
+		}
+		return "%v"
+	}
+
+	format := "%"
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			format += string(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		format += strconv.Itoa(width)
+	}
+	if precision, ok := f.Precision(); ok {
+		format += "." + strconv.Itoa(precision)
+	}
+	return format + string(verb)
+}
@@ -0,0 +1,89 @@
+package collections
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go/parser"
+	"reflect"
+	"testing"
+)
+
+type bag struct {
+	values []any
+	keys   []any
+	// keyType and valType are the types of the keys and values, any if nil
+	keyType, valType reflect.Type
+}
+
+func (b *bag) Format(f fmt.State, verb rune) {
+	contents := Contents{Size: len(b.values), Open: "[", Close: "]", Keyed: b.keys != nil,
+		KeyType: b.keyType, ValType: b.valType,
+		Each: func(visit func(key, val any) bool) {
+			for i, val := range b.values {
+				var key any
+				if b.keys != nil {
+					key = b.keys[i]
+				}
+				if !visit(key, val) {
+					return
+				}
+			}
+		},
+	}
+	if b.keys != nil {
+		contents.Open, contents.Close = "{", "}"
+	}
+	Format(f, verb, b, contents)
+}
+
+func TestFormat_Verbs(t *testing.T) {
+	values := &bag{values: []any{1, "two", 3.5}}
+
+	assert.Equal(t, "[1, two, 3.5]", fmt.Sprintf("%v", values))
+	assert.Equal(t, "collections.bag size=3 [1, two, 3.5]", fmt.Sprintf("%+v", values))
+	assert.Equal(t, `[]interface {}{1, "two", 3.5}`, fmt.Sprintf("%#v", values))
+	assert.Equal(t, "[]", fmt.Sprintf("%v", &bag{}))
+}
+
+func TestFormat_Keyed(t *testing.T) {
+	m := &bag{keys: []any{"a", "b"}, values: []any{1, 2}}
+
+	assert.Equal(t, "{a: 1, b: 2}", fmt.Sprint(m))
+	assert.Equal(t, `map[interface {}]interface {}{"a": 1, "b": 2}`, fmt.Sprintf("%#v", m))
+}
+
+func TestFormat_GoSyntax(t *testing.T) {
+	for _, test := range []struct {
+		collection *bag
+		expected   string
+	}{
+		{&bag{valType: TypeOf[string](), values: []any{"a", "b"}}, `[]string{"a", "b"}`},
+		{&bag{valType: TypeOf[int]()}, `[]int{}`},
+		{&bag{keyType: TypeOf[string](), valType: TypeOf[int](), keys: []any{"a"}, values: []any{1}},
+			`map[string]int{"a": 1}`},
+		// slices cannot be map keys
+		{&bag{keyType: TypeOf[[]int](), valType: TypeOf[string](), keys: []any{[]int{1}}, values: []any{"a"}},
+			`[]struct { Key []int; Val string }{{Key: []int{1}, Val: "a"}}`},
+	} {
+		formatted := fmt.Sprintf("%#v", test.collection)
+		assert.Equal(t, test.expected, formatted)
+		_, err := parser.ParseExpr(formatted)
+		assert.NoError(t, err, formatted)
+	}
+}
+
+func TestFormat_Truncation(t *testing.T) {
+	values := &bag{values: []any{1, 2, 3, 4, 5}}
+
+	assert.Equal(t, "[1, 2, ... 3 more]", fmt.Sprintf("%.2v", values))
+	assert.Equal(t, "collections.bag size=5 [1, ... 4 more]", fmt.Sprintf("%+.1v", values))
+	assert.Equal(t, "[... 5 more]", fmt.Sprintf("%.0v", values))
+	assert.Equal(t, "[1, 2, 3, 4, 5]", fmt.Sprintf("%.5v", values))
+	assert.Equal(t, "[]interface {}{1, /* 4 more */}", fmt.Sprintf("%#.1v", values))
+}
+
+func TestFormat_ElementVerbs(t *testing.T) {
+	assert.Equal(t, "[0a, ff]", fmt.Sprintf("%02x", &bag{values: []any{10, 255}}))
+	assert.Equal(t, `["a", "b"]`, fmt.Sprintf("%q", &bag{values: []any{"a", "b"}}))
+	assert.Equal(t, "[1.50, 2.25]", fmt.Sprintf("%.2f", &bag{values: []any{1.5, 2.25}}))
+}
@@ -0,0 +1,218 @@
+package immutable
+
+import (
+	"fmt"
+	"utils-generics/collections/codec"
+	"utils-generics/collections/dict"
+)
+
+// File: binary.go
+// Binary encoding of the persistent collections, using the same layouts as their mutable counterparts.
+// Like for JSON, decoding is only allowed into an empty collection that is not yet shared.
+
+// MarshalBinary encodes the map with the default codecs of its keys and values
+func (m *ImmutableHashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return m.EncodeBinary(codec.Default[K](), codec.Default[V]())
+}
+
+// UnmarshalBinary decodes the entries with the default codecs into an empty map created with MakeImmutableHashMap
+func (m *ImmutableHashMap[K, V]) UnmarshalBinary(data []byte) error {
+	return m.DecodeBinary(data, codec.Default[K](), codec.Default[V]())
+}
+
+// EncodeBinary encodes the map with the given codecs
+func (m *ImmutableHashMap[K, V]) EncodeBinary(kc codec.Codec[K], vc codec.Codec[V]) ([]byte, error) {
+	return dict.EncodeEntries(m.Entries(), kc, vc)
+}
+
+// DecodeBinary decodes the entries with the given codecs into an empty map created with MakeImmutableHashMap
+func (m *ImmutableHashMap[K, V]) DecodeBinary(data []byte, kc codec.Codec[K], vc codec.Codec[V]) error {
+	if m.hasher == nil {
+		return errNotConstructed("ImmutableHashMap")
+	}
+	if m.size != 0 {
+		return errNotEmpty("ImmutableHashMap")
+	}
+	entries, err := dict.DecodeEntries(data, kc, vc)
+	if err != nil {
+		return err
+	}
+
+	b := m.ToBuilder()
+	for _, entry := range entries {
+		b.Put(entry.Key, entry.Val)
+	}
+	*m = *b.Build()
+	return nil
+}
+
+// MarshalBinary encodes the set with the default codec of its elements
+func (s *ImmutableHashSet[K]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K]())
+}
+
+// UnmarshalBinary decodes the elements with the default codec into an empty set created with MakeImmutableHashSet
+func (s *ImmutableHashSet[K]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K]())
+}
+
+// EncodeBinary encodes the set with the given codec
+func (s *ImmutableHashSet[K]) EncodeBinary(c codec.Codec[K]) ([]byte, error) {
+	return codec.EncodeValues(s.ToSlice(), c)
+}
+
+// DecodeBinary decodes the elements with the given codec into an empty set created with MakeImmutableHashSet
+func (s *ImmutableHashSet[K]) DecodeBinary(data []byte, c codec.Codec[K]) error {
+	if s.innerMap == nil {
+		return errNotConstructed("ImmutableHashSet")
+	}
+	if s.innerMap.size != 0 {
+		return errNotEmpty("ImmutableHashSet")
+	}
+	elements, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+
+	b := s.ToBuilder()
+	for _, val := range elements {
+		b.Add(val)
+	}
+	*s = *b.Build()
+	return nil
+}
+
+// MarshalBinary encodes the map in key order with the default codecs of its keys and values
+func (m *ImmutableTreeMap[K, V]) MarshalBinary() ([]byte, error) {
+	return m.EncodeBinary(codec.Default[K](), codec.Default[V]())
+}
+
+// UnmarshalBinary decodes the entries with the default codecs into an empty map created with MakeImmutableTreeMap
+func (m *ImmutableTreeMap[K, V]) UnmarshalBinary(data []byte) error {
+	return m.DecodeBinary(data, codec.Default[K](), codec.Default[V]())
+}
+
+// EncodeBinary encodes the map in key order with the given codecs
+func (m *ImmutableTreeMap[K, V]) EncodeBinary(kc codec.Codec[K], vc codec.Codec[V]) ([]byte, error) {
+	return dict.EncodeEntries(m.Entries(), kc, vc)
+}
+
+// DecodeBinary decodes the entries with the given codecs into an empty map created with MakeImmutableTreeMap.
+// The entries are expected in key order, as written by EncodeBinary, which allows to build the tree in O(n).
+func (m *ImmutableTreeMap[K, V]) DecodeBinary(data []byte, kc codec.Codec[K], vc codec.Codec[V]) error {
+	if m.comparator == nil {
+		return errNotConstructed("ImmutableTreeMap")
+	}
+	if m.root != nil {
+		return errNotEmpty("ImmutableTreeMap")
+	}
+	entries, err := dict.DecodeEntries(data, kc, vc)
+	if err != nil {
+		return err
+	}
+	for i := 1; i < len(entries); i++ {
+		if m.comparator(entries[i-1].Key, entries[i].Key) >= 0 {
+			return fmt.Errorf("immutable: keys %v and %v are not in strictly increasing order", entries[i-1].Key, entries[i].Key)
+		}
+	}
+
+	m.root = buildTree(entries)
+	return nil
+}
+
+// buildTree builds a perfectly balanced tree out of sorted entries, which satisfies the weight balance invariant
+func buildTree[K any, V any](entries []dict.Entry[K, V]) *treeNode[K, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+	mid := len(entries) / 2
+	return newTreeNode(entries[mid], buildTree(entries[:mid]), buildTree(entries[mid+1:]))
+}
+
+// MarshalBinary encodes the list with the default codec of its values
+func (l *ImmutableList[T]) MarshalBinary() ([]byte, error) {
+	return l.EncodeBinary(codec.Default[T]())
+}
+
+// UnmarshalBinary decodes the values with the default codec into an empty list
+func (l *ImmutableList[T]) UnmarshalBinary(data []byte) error {
+	return l.DecodeBinary(data, codec.Default[T]())
+}
+
+// EncodeBinary encodes the list with the given codec
+func (l *ImmutableList[T]) EncodeBinary(c codec.Codec[T]) ([]byte, error) {
+	return codec.EncodeValues(l.ToSlice(), c)
+}
+
+// DecodeBinary decodes the values with the given codec into an empty list
+func (l *ImmutableList[T]) DecodeBinary(data []byte, c codec.Codec[T]) error {
+	if l.size != 0 {
+		return errNotEmpty("ImmutableList")
+	}
+	values, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+	*l = *MakeImmutableList(values...)
+	return nil
+}
+
+// MarshalBinary encodes the vector with the default codec of its values
+func (v *Vector[T]) MarshalBinary() ([]byte, error) {
+	return v.EncodeBinary(codec.Default[T]())
+}
+
+// UnmarshalBinary decodes the values with the default codec into an empty vector
+func (v *Vector[T]) UnmarshalBinary(data []byte) error {
+	return v.DecodeBinary(data, codec.Default[T]())
+}
+
+// EncodeBinary encodes the vector with the given codec
+func (v *Vector[T]) EncodeBinary(c codec.Codec[T]) ([]byte, error) {
+	return codec.EncodeValues(v.ToSlice(), c)
+}
+
+// DecodeBinary decodes the values with the given codec into an empty vector
+func (v *Vector[T]) DecodeBinary(data []byte, c codec.Codec[T]) error {
+	if v.size != 0 {
+		return errNotEmpty("Vector")
+	}
+	values, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+	*v = *MakeVector(values...)
+	return nil
+}
+
+// MarshalBinary encodes the stack from the bottom to the top with the default codec of its values
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[T]())
+}
+
+// UnmarshalBinary decodes the values with the default codec into an empty stack, the last value being the top
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[T]())
+}
+
+// EncodeBinary encodes the stack from the bottom to the top with the given codec
+func (s *Stack[T]) EncodeBinary(c codec.Codec[T]) ([]byte, error) {
+	var values []T
+	if s.values != nil {
+		values = s.values.Reverse().ToSlice()
+	}
+	return codec.EncodeValues(values, c)
+}
+
+// DecodeBinary decodes the values with the given codec into an empty stack, the last value being the top
+func (s *Stack[T]) DecodeBinary(data []byte, c codec.Codec[T]) error {
+	if s.values != nil && s.values.size != 0 {
+		return errNotEmpty("Stack")
+	}
+	values, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+	s.values = MakeImmutableList(values...).Reverse()
+	return nil
+}
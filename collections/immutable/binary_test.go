@@ -0,0 +1,97 @@
+package immutable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestImmutableHashMap_Binary(t *testing.T) {
+	m := MakeImmutableHashMap[int, string](types.IntHash)
+	for i := 0; i < 100; i++ {
+		m = m.Put(i, "v")
+	}
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeImmutableHashMap[int, string](types.IntHash)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.True(t, m.Equal(decoded))
+	assert.Error(t, decoded.UnmarshalBinary(data))
+}
+
+func TestImmutableHashSet_Binary(t *testing.T) {
+	s := MakeImmutableHashSet[string](types.StringHash).Add("a").Add("b")
+
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeImmutableHashSet[string](types.StringHash)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.True(t, s.Equal(decoded))
+}
+
+func TestImmutableTreeMap_Binary_ReloadsBalanced(t *testing.T) {
+	m := MakeImmutableTreeMap[int, int](types.IntComparator)
+	for i := 0; i < 1000; i++ {
+		m = m.Put(i, i*i)
+	}
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeImmutableTreeMap[int, int](types.IntComparator)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+	assert.Equal(t, 10, height(decoded.root))
+	assertBalanced(t, decoded.root)
+
+	// the decoded map keeps working as a regular one
+	decoded = decoded.Put(1000, 0).Remove(0)
+	assert.Equal(t, 1000, decoded.Size())
+	assertBalanced(t, decoded.root)
+}
+
+func TestImmutableList_Binary(t *testing.T) {
+	data, err := MakeImmutableList("x", "y").MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded ImmutableList[string]
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []string{"x", "y"}, decoded.ToSlice())
+}
+
+func TestVector_Binary(t *testing.T) {
+	data, err := MakeVector(1.5, 2.5).MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeVector[float64]()
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []float64{1.5, 2.5}, decoded.ToSlice())
+}
+
+func TestStack_Binary(t *testing.T) {
+	data, err := MakeStack[int]().Push(1).Push(2).MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeStack[int]()
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	top, _ := decoded.Peek()
+	assert.Equal(t, 2, top)
+}
+
+// assertBalanced checks the weight balance invariant on every node, along with the cached sizes
+func assertBalanced[K any, V any](t *testing.T, node *treeNode[K, V]) {
+	if node == nil {
+		return
+	}
+	sl, sr := treeSize(node.left), treeSize(node.right)
+	assert.Equal(t, sl+sr+1, node.size)
+	if sl+sr > 1 {
+		assert.LessOrEqual(t, sl, treeDelta*sr)
+		assert.LessOrEqual(t, sr, treeDelta*sl)
+	}
+	assertBalanced(t, node.left)
+	assertBalanced(t, node.right)
+}
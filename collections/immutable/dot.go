@@ -0,0 +1,23 @@
+package immutable
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: dot.go
+// Graphviz DOT export of the trees, see collections.DOTGraph.
+
+// ToDOT returns the shape of the tree as a Graphviz DOT digraph, every node labelled with its entry
+// and the size of its subtree
+func (m *ImmutableTreeMap[K, V]) ToDOT() string {
+	g := collections.MakeDOTGraph("ImmutableTreeMap", "node [shape=box]")
+	collections.AddBinaryTreeDOT(g, m.root,
+		func(node *treeNode[K, V]) (*treeNode[K, V], *treeNode[K, V]) {
+			return node.left, node.right
+		},
+		func(node *treeNode[K, V]) (string, []string) {
+			return fmt.Sprintf("%v: %v\nsize=%d", node.Key, node.Val, node.size), nil
+		})
+	return g.String()
+}
@@ -0,0 +1,39 @@
+package immutable
+
+import (
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func assertGolden(t *testing.T, name string, actual string) {
+	golden := filepath.Join("testdata", name+".dot.golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(actual), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(expected), actual)
+}
+
+func TestImmutableTreeMap_ToDOT(t *testing.T) {
+	m := MakeImmutableTreeMap[string, int](types.StringComparator)
+	for i, key := range []string{"a", "b", "c", "d", "e"} {
+		m = m.Put(key, i)
+	}
+
+	assertGolden(t, "immutable_tree_map", m.ToDOT())
+}
@@ -0,0 +1,121 @@
+package immutable
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: format.go
+// Printing of the persistent collections through fmt, see collections.Format for the supported verbs and flags.
+
+// String returns the entries of the map, as printed by the %v verb
+func (m *ImmutableHashMap[K, V]) String() string {
+	return fmt.Sprint(m)
+}
+
+// Format implements fmt.Formatter
+func (m *ImmutableHashMap[K, V]) Format(f fmt.State, verb rune) {
+	entries := m.Entries()
+	collections.Format(f, verb, m, collections.Contents{Size: len(entries), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[V](),
+		Each: func(visit func(key, val any) bool) {
+			for _, entry := range entries {
+				if !visit(entry.Key, entry.Val) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the elements of the set, as printed by the %v verb
+func (s *ImmutableHashSet[K]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter
+func (s *ImmutableHashSet[K]) Format(f fmt.State, verb rune) {
+	elements := s.ToSlice()
+	collections.Format(f, verb, s, collections.Contents{Size: len(elements), Open: "{", Close: "}",
+		ValType: collections.TypeOf[K](),
+		Each: func(visit func(key, val any) bool) {
+			for _, val := range elements {
+				if !visit(nil, val) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the entries of the map in key order, as printed by the %v verb
+func (m *ImmutableTreeMap[K, V]) String() string {
+	return fmt.Sprint(m)
+}
+
+// Format implements fmt.Formatter, printing the entries in key order
+func (m *ImmutableTreeMap[K, V]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, m, collections.Contents{Size: m.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[V](),
+		Each: func(visit func(key, val any) bool) {
+			m.ForEach(func(key K, val V) bool {
+				return visit(key, val)
+			})
+		},
+	})
+}
+
+// String returns the values of the list, as printed by the %v verb
+func (l *ImmutableList[T]) String() string {
+	return fmt.Sprint(l)
+}
+
+// Format implements fmt.Formatter
+func (l *ImmutableList[T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, l, collections.Contents{Size: l.size, Open: "[", Close: "]",
+		ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			for current := l.head; current != nil; current = current.next {
+				if !visit(nil, current.val) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the values of the vector, as printed by the %v verb
+func (v *Vector[T]) String() string {
+	return fmt.Sprint(v)
+}
+
+// Format implements fmt.Formatter
+func (v *Vector[T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, v, collections.Contents{Size: v.size, Open: "[", Close: "]",
+		ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			v.ForEach(func(_ int, val T) bool {
+				return visit(nil, val)
+			})
+		},
+	})
+}
+
+// String returns the values of the stack from the top to the bottom, as printed by the %v verb
+func (s *Stack[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the values from the top to the bottom
+func (s *Stack[T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: s.Size(), Open: "[", Close: "]",
+		ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			for current := s.values.head; current != nil; current = current.next {
+				if !visit(nil, current.val) {
+					return
+				}
+			}
+		},
+	})
+}
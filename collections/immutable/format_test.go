@@ -0,0 +1,17 @@
+package immutable
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestImmutable_Format(t *testing.T) {
+	m := MakeImmutableTreeMap[int, string](types.IntComparator).Put(2, "b").Put(1, "a")
+
+	assert.Equal(t, "immutable.ImmutableTreeMap[int,string] size=2 {1: a, 2: b}", fmt.Sprintf("%+v", m))
+	assert.Equal(t, "[1, 2, ... 1 more]", fmt.Sprintf("%.2v", MakeVector(1, 2, 3)))
+	assert.Equal(t, `[]string{"a"}`, fmt.Sprintf("%#v", MakeImmutableList("a")))
+	assert.Equal(t, "[b, a]", MakeStack[string]().Push("a").Push("b").String())
+}
@@ -0,0 +1,261 @@
+package immutable
+
+import "math/bits"
+
+// File: hamt.go
+// Hash Array Mapped Trie nodes, in their compressed (CHAMP) variant: every node keeps its inline entries and its
+// sub-nodes in two separate arrays indexed by two bitmaps. Deletions pull single entries back up the trie so that
+// a given set of keys always yields the same trie, which makes structural equality a cheap recursive comparison.
+
+const (
+	hamtBits  = 5
+	hamtMask  = 1<<hamtBits - 1
+	hashWidth = 32 // past this shift all the hash bits are consumed and keys are kept in collision nodes
+)
+
+// hamtOwner identifies the transient (builder) allowed to mutate a node in place
+type hamtOwner struct {
+	_ byte // non-zero size so that every owner has a distinct address
+}
+
+type hamtEntry[K any, V any] struct {
+	key  K
+	val  V
+	hash uint32
+}
+
+type hamtNode[K any, V any] struct {
+	dataMap  uint32
+	nodeMap  uint32
+	entries  []hamtEntry[K, V]
+	children []*hamtNode[K, V]
+	owner    *hamtOwner
+}
+
+func bitPosition(hash uint32, shift uint) uint32 {
+	return 1 << ((hash >> shift) & hamtMask)
+}
+
+func bitIndex(bitmap uint32, bit uint32) int {
+	return bits.OnesCount32(bitmap & (bit - 1))
+}
+
+// editable returns a node that can be modified by the owner, which is the node itself if the owner already holds it
+// or a copy otherwise. A nil owner always gets a copy.
+func (n *hamtNode[K, V]) editable(owner *hamtOwner) *hamtNode[K, V] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+
+	return &hamtNode[K, V]{
+		dataMap:  n.dataMap,
+		nodeMap:  n.nodeMap,
+		entries:  append([]hamtEntry[K, V](nil), n.entries...),
+		children: append([]*hamtNode[K, V](nil), n.children...),
+		owner:    owner,
+	}
+}
+
+func (n *hamtNode[K, V]) get(key K, hash uint32, shift uint, equals func(a, b K) bool) (V, bool) {
+	for shift < hashWidth {
+		bit := bitPosition(hash, shift)
+		if n.dataMap&bit != 0 {
+			e := n.entries[bitIndex(n.dataMap, bit)]
+			if e.hash == hash && equals(e.key, key) {
+				return e.val, true
+			}
+			var zero V
+			return zero, false
+		}
+		if n.nodeMap&bit == 0 {
+			var zero V
+			return zero, false
+		}
+		n = n.children[bitIndex(n.nodeMap, bit)]
+		shift += hamtBits
+	}
+
+	// collision node
+	for _, e := range n.entries {
+		if equals(e.key, key) {
+			return e.val, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// put returns the node with the entry added or replaced, along with true if the key was not present before
+func (n *hamtNode[K, V]) put(owner *hamtOwner, e hamtEntry[K, V], shift uint, equals func(a, b K) bool) (*hamtNode[K, V], bool) {
+	if shift >= hashWidth {
+		for i := range n.entries {
+			if equals(n.entries[i].key, e.key) {
+				edited := n.editable(owner)
+				edited.entries[i].val = e.val
+				return edited, false
+			}
+		}
+		edited := n.editable(owner)
+		edited.entries = append(edited.entries, e)
+		return edited, true
+	}
+
+	bit := bitPosition(e.hash, shift)
+	if n.dataMap&bit != 0 {
+		i := bitIndex(n.dataMap, bit)
+		current := n.entries[i]
+		if current.hash == e.hash && equals(current.key, e.key) {
+			edited := n.editable(owner)
+			edited.entries[i].val = e.val
+			return edited, false
+		}
+
+		// both entries share this slot, so they are pushed down into a new sub-node
+		child := mergeEntries(owner, current, e, shift+hamtBits)
+		edited := n.editable(owner)
+		edited.entries = removeAt(edited.entries, i)
+		edited.dataMap ^= bit
+		edited.nodeMap |= bit
+		edited.children = insertAt(edited.children, bitIndex(edited.nodeMap, bit), child)
+		return edited, true
+	}
+
+	if n.nodeMap&bit != 0 {
+		i := bitIndex(n.nodeMap, bit)
+		child, added := n.children[i].put(owner, e, shift+hamtBits, equals)
+		edited := n.editable(owner)
+		edited.children[i] = child
+		return edited, added
+	}
+
+	edited := n.editable(owner)
+	edited.dataMap |= bit
+	edited.entries = insertAt(edited.entries, bitIndex(edited.dataMap, bit), e)
+	return edited, true
+}
+
+func mergeEntries[K any, V any](owner *hamtOwner, a, b hamtEntry[K, V], shift uint) *hamtNode[K, V] {
+	if shift >= hashWidth {
+		return &hamtNode[K, V]{entries: []hamtEntry[K, V]{a, b}, owner: owner}
+	}
+
+	bitA, bitB := bitPosition(a.hash, shift), bitPosition(b.hash, shift)
+	if bitA == bitB {
+		child := mergeEntries(owner, a, b, shift+hamtBits)
+		return &hamtNode[K, V]{nodeMap: bitA, children: []*hamtNode[K, V]{child}, owner: owner}
+	}
+
+	if bitA > bitB {
+		a, b = b, a
+	}
+	return &hamtNode[K, V]{dataMap: bitA | bitB, entries: []hamtEntry[K, V]{a, b}, owner: owner}
+}
+
+// remove returns the node without the entry identified by the key, along with true if the key was present
+func (n *hamtNode[K, V]) remove(owner *hamtOwner, key K, hash uint32, shift uint, equals func(a, b K) bool) (*hamtNode[K, V], bool) {
+	if shift >= hashWidth {
+		for i := range n.entries {
+			if equals(n.entries[i].key, key) {
+				edited := n.editable(owner)
+				edited.entries = removeAt(edited.entries, i)
+				return edited, true
+			}
+		}
+		return n, false
+	}
+
+	bit := bitPosition(hash, shift)
+	if n.dataMap&bit != 0 {
+		i := bitIndex(n.dataMap, bit)
+		if n.entries[i].hash != hash || !equals(n.entries[i].key, key) {
+			return n, false
+		}
+		edited := n.editable(owner)
+		edited.entries = removeAt(edited.entries, i)
+		edited.dataMap ^= bit
+		return edited, true
+	}
+
+	if n.nodeMap&bit != 0 {
+		i := bitIndex(n.nodeMap, bit)
+		child, removed := n.children[i].remove(owner, key, hash, shift+hamtBits, equals)
+		if !removed {
+			return n, false
+		}
+
+		edited := n.editable(owner)
+		if child.nodeMap == 0 && len(child.entries) == 1 {
+			// keep the trie canonical: a sub-node holding a single entry is inlined in its parent
+			edited.children = removeAt(edited.children, i)
+			edited.nodeMap ^= bit
+			edited.dataMap |= bit
+			edited.entries = insertAt(edited.entries, bitIndex(edited.dataMap, bit), child.entries[0])
+		} else {
+			edited.children[i] = child
+		}
+		return edited, true
+	}
+
+	return n, false
+}
+
+func (n *hamtNode[K, V]) forEach(visit func(e *hamtEntry[K, V])) {
+	for i := range n.entries {
+		visit(&n.entries[i])
+	}
+	for _, child := range n.children {
+		child.forEach(visit)
+	}
+}
+
+// equal compares two tries, skipping every subtree they share
+func (n *hamtNode[K, V]) equal(other *hamtNode[K, V], shift uint, keyEquals func(a, b K) bool, valEquals func(a, b V) bool) bool {
+	if n == other {
+		return true
+	}
+
+	if shift >= hashWidth {
+		if len(n.entries) != len(other.entries) {
+			return false
+		}
+		// collision nodes are unordered
+		for _, e := range n.entries {
+			val, ok := other.get(e.key, e.hash, shift, keyEquals)
+			if !ok || !valEquals(e.val, val) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if n.dataMap != other.dataMap || n.nodeMap != other.nodeMap {
+		return false
+	}
+	for i, e := range n.entries {
+		o := other.entries[i]
+		if e.hash != o.hash || !keyEquals(e.key, o.key) || !valEquals(e.val, o.val) {
+			return false
+		}
+	}
+	for i, child := range n.children {
+		if !child.equal(other.children[i], shift+hamtBits, keyEquals, valEquals) {
+			return false
+		}
+	}
+	return true
+}
+
+func insertAt[T any](s []T, i int, v T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+func removeAt[T any](s []T, i int) []T {
+	copy(s[i:], s[i+1:])
+	var zero T
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
@@ -0,0 +1,199 @@
+package immutable
+
+import (
+	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
+)
+
+// ImmutableHashMap is a persistent map implementation using a Hash Array Mapped Trie.
+//
+// It is never modified in place: Put and Remove return a new version of the map that shares most of its structure
+// with the previous one, which remains valid and unchanged. This makes it safe to share between goroutines
+// without copying or locking.
+// It makes no guarantees on ordering of its entries.
+//
+// It's performance characteristics are:
+//
+// - Put: O(log32 n)
+//
+// - Get: O(log32 n)
+//
+// - Remove: O(log32 n)
+type ImmutableHashMap[K any, V any] struct {
+	root   *hamtNode[K, V]
+	size   int
+	hasher func(K) int
+	equals func(a, b K) bool
+}
+
+// MakeImmutableHashMap creates a new, empty, ImmutableHashMap
+func MakeImmutableHashMap[K any, V any](h func(K) int) *ImmutableHashMap[K, V] {
+	return &ImmutableHashMap[K, V]{
+		root:   &hamtNode[K, V]{},
+		hasher: h,
+		equals: types.DeepEquals[K],
+	}
+}
+
+func (m *ImmutableHashMap[K, V]) hash(key K) uint32 {
+	return uint32(m.hasher(key))
+}
+
+// Put returns a new version of the map with the entry added.
+// If an entry with the key already exists its value is replaced in the new version.
+func (m *ImmutableHashMap[K, V]) Put(key K, val V) *ImmutableHashMap[K, V] {
+	root, added := m.root.put(nil, hamtEntry[K, V]{key: key, val: val, hash: m.hash(key)}, 0, m.equals)
+	size := m.size
+	if added {
+		size++
+	}
+	return checked(&ImmutableHashMap[K, V]{root: root, size: size, hasher: m.hasher, equals: m.equals})
+}
+
+// Remove returns a new version of the map without the entry identified by the key.
+// If the key does not exist the map itself is returned.
+func (m *ImmutableHashMap[K, V]) Remove(key K) *ImmutableHashMap[K, V] {
+	root, removed := m.root.remove(nil, key, m.hash(key), 0, m.equals)
+	if !removed {
+		return m
+	}
+	return checked(&ImmutableHashMap[K, V]{root: root, size: m.size - 1, hasher: m.hasher, equals: m.equals})
+}
+
+// Get returns the value associated with the provided key and true if the key was found and false if otherwise
+func (m *ImmutableHashMap[K, V]) Get(key K) (V, bool) {
+	return m.root.get(key, m.hash(key), 0, m.equals)
+}
+
+// ContainsKey returns true if the map contains an entry with the provided key and false if otherwise
+func (m *ImmutableHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Size returns the number of entries in the map
+func (m *ImmutableHashMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty returns true if the map is empty and false if otherwise
+func (m *ImmutableHashMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// IsNotEmpty returns true if the map is not empty and false if otherwise
+func (m *ImmutableHashMap[K, V]) IsNotEmpty() bool {
+	return m.size != 0
+}
+
+// Formatted returns a string representation of the map
+func (m *ImmutableHashMap[K, V]) Formatted() string {
+	return m.String()
+}
+
+// Entries returns a slice of all entries in the map
+func (m *ImmutableHashMap[K, V]) Entries() []dict.Entry[K, V] {
+	entries := make([]dict.Entry[K, V], 0, m.size)
+	m.root.forEach(func(e *hamtEntry[K, V]) {
+		entries = append(entries, dict.Entry[K, V]{Key: e.key, Val: e.val})
+	})
+	return entries
+}
+
+// Keys returns a slice of all keys in the map
+func (m *ImmutableHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.root.forEach(func(e *hamtEntry[K, V]) {
+		keys = append(keys, e.key)
+	})
+	return keys
+}
+
+// Values returns a slice of all values in the map
+func (m *ImmutableHashMap[K, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	m.root.forEach(func(e *hamtEntry[K, V]) {
+		values = append(values, e.val)
+	})
+	return values
+}
+
+// Equal returns true if both maps hold the same entries, comparing values with reflect.DeepEqual.
+//
+// Subtrees shared by both versions are not visited, so comparing a map with a version derived from it
+// is proportional to the changes between them rather than to their size.
+func (m *ImmutableHashMap[K, V]) Equal(other *ImmutableHashMap[K, V]) bool {
+	return m.EqualFunc(other, types.DeepEquals[V])
+}
+
+// EqualFunc returns true if both maps hold the same entries, comparing values with the provided function
+func (m *ImmutableHashMap[K, V]) EqualFunc(other *ImmutableHashMap[K, V], valEquals func(a, b V) bool) bool {
+	if m.size != other.size {
+		return false
+	}
+	return m.root.equal(other.root, 0, m.equals, valEquals)
+}
+
+// ToBuilder returns a builder initialised with the entries of the map, leaving the map itself untouched
+func (m *ImmutableHashMap[K, V]) ToBuilder() *ImmutableHashMapBuilder[K, V] {
+	return &ImmutableHashMapBuilder[K, V]{root: m.root, size: m.size, hasher: m.hasher, equals: m.equals, owner: &hamtOwner{}}
+}
+
+// ----------------
+// Builder
+
+// ImmutableHashMapBuilder is the transient counterpart of ImmutableHashMap, meant for bulk construction.
+//
+// It modifies in place the nodes it created itself, instead of copying them on every operation,
+// and hands them over to an ImmutableHashMap when Build is called. It is not thread safe.
+type ImmutableHashMapBuilder[K any, V any] struct {
+	root   *hamtNode[K, V]
+	size   int
+	hasher func(K) int
+	equals func(a, b K) bool
+	owner  *hamtOwner
+}
+
+// MakeImmutableHashMapBuilder creates a new, empty, ImmutableHashMapBuilder
+func MakeImmutableHashMapBuilder[K any, V any](h func(K) int) *ImmutableHashMapBuilder[K, V] {
+	return MakeImmutableHashMap[K, V](h).ToBuilder()
+}
+
+// Put adds a new entry to the builder. If the key already exists, its value is replaced.
+func (b *ImmutableHashMapBuilder[K, V]) Put(key K, val V) {
+	var added bool
+	b.root, added = b.root.put(b.owner, hamtEntry[K, V]{key: key, val: val, hash: uint32(b.hasher(key))}, 0, b.equals)
+	if added {
+		b.size++
+	}
+}
+
+// Remove removes the entry identified by the key, returning true if the entry was found and removed
+// and false if the entry was not found
+func (b *ImmutableHashMapBuilder[K, V]) Remove(key K) bool {
+	var removed bool
+	b.root, removed = b.root.remove(b.owner, key, uint32(b.hasher(key)), 0, b.equals)
+	if removed {
+		b.size--
+	}
+	return removed
+}
+
+// Get returns the value associated with the provided key and true if the key was found and false if otherwise
+func (b *ImmutableHashMapBuilder[K, V]) Get(key K) (V, bool) {
+	return b.root.get(key, uint32(b.hasher(key)), 0, b.equals)
+}
+
+// Size returns the number of entries in the builder
+func (b *ImmutableHashMapBuilder[K, V]) Size() int {
+	return b.size
+}
+
+// Build returns an ImmutableHashMap with the entries of the builder.
+//
+// The builder can still be used afterwards; further changes do not affect the maps already built.
+func (b *ImmutableHashMapBuilder[K, V]) Build() *ImmutableHashMap[K, V] {
+	// a fresh owner makes every node built so far read-only for the builder
+	b.owner = &hamtOwner{}
+	return checked(&ImmutableHashMap[K, V]{root: b.root, size: b.size, hasher: b.hasher, equals: b.equals})
+}
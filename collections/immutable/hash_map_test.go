@@ -0,0 +1,158 @@
+package immutable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestImmutableHashMap_Put(t *testing.T) {
+	m := MakeImmutableHashMap[string, int](types.StringHash)
+	m1 := m.Put("one", 1)
+	m2 := m1.Put("two", 2)
+
+	assert.Equal(t, 0, m.Size())
+	assert.Equal(t, 1, m1.Size())
+	assert.Equal(t, 2, m2.Size())
+
+	val, ok := m2.Get("two")
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+	assert.False(t, m1.ContainsKey("two"))
+}
+
+func TestImmutableHashMap_PutReplaces(t *testing.T) {
+	m1 := MakeImmutableHashMap[string, int](types.StringHash).Put("one", 1)
+	m2 := m1.Put("one", 11)
+
+	assert.Equal(t, 1, m2.Size())
+	val, _ := m1.Get("one")
+	assert.Equal(t, 1, val)
+	val, _ = m2.Get("one")
+	assert.Equal(t, 11, val)
+}
+
+func TestImmutableHashMap_Remove(t *testing.T) {
+	m1 := MakeImmutableHashMap[string, int](types.StringHash).Put("one", 1).Put("two", 2)
+	m2 := m1.Remove("one")
+
+	assert.Equal(t, 1, m2.Size())
+	assert.False(t, m2.ContainsKey("one"))
+	assert.True(t, m1.ContainsKey("one"))
+	assert.Same(t, m2, m2.Remove("three"))
+}
+
+func TestImmutableHashMap_Collisions(t *testing.T) {
+	constant := func(int) int { return 42 }
+	m := MakeImmutableHashMap[int, string](constant).Put(1, "one").Put(2, "two").Put(3, "three")
+
+	assert.Equal(t, 3, m.Size())
+	val, ok := m.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, "two", val)
+
+	m = m.Remove(2).Remove(1)
+	assert.Equal(t, 1, m.Size())
+	val, ok = m.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, "three", val)
+	assert.True(t, m.Equal(MakeImmutableHashMap[int, string](constant).Put(3, "three")))
+}
+
+func TestImmutableHashMap_MatchesBuiltinMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	collide := func(i int) int { return i % 1000 }
+	m := MakeImmutableHashMap[int, int](collide)
+	model := map[int]int{}
+
+	var versions []*ImmutableHashMap[int, int]
+	var models []map[int]int
+	for i := 0; i < 20000; i++ {
+		key := r.Intn(5000)
+		if r.Intn(3) == 0 {
+			m = m.Remove(key)
+			delete(model, key)
+		} else {
+			m = m.Put(key, i)
+			model[key] = i
+		}
+
+		if i%2000 == 0 {
+			snapshot := map[int]int{}
+			for k, v := range model {
+				snapshot[k] = v
+			}
+			versions = append(versions, m)
+			models = append(models, snapshot)
+		}
+	}
+
+	versions = append(versions, m)
+	models = append(models, model)
+	for i, version := range versions {
+		assert.Equal(t, len(models[i]), version.Size())
+		for k, v := range models[i] {
+			val, ok := version.Get(k)
+			assert.True(t, ok)
+			assert.Equal(t, v, val)
+		}
+		assert.Len(t, version.Entries(), len(models[i]))
+	}
+}
+
+func TestImmutableHashMap_Equal(t *testing.T) {
+	a := MakeImmutableHashMap[int, int](types.IntHash)
+	b := MakeImmutableHashMap[int, int](types.IntHash)
+	for i := 0; i < 1000; i++ {
+		a = a.Put(i, i)
+		b = b.Put(999-i, 999-i)
+	}
+
+	assert.True(t, a.Equal(b))
+	assert.False(t, a.Equal(b.Put(0, 1)))
+	assert.False(t, a.Equal(b.Remove(0)))
+	assert.True(t, a.Equal(a.Put(5000, 1).Remove(5000)))
+}
+
+func TestImmutableHashMap_Formatted(t *testing.T) {
+	m := MakeImmutableHashMap[int, string](types.IntHash).Put(1, "one")
+
+	assert.Equal(t, "{1: one}", m.Formatted())
+	assert.Equal(t, "{}", m.Remove(1).Formatted())
+}
+
+func TestImmutableHashMapBuilder_Build(t *testing.T) {
+	b := MakeImmutableHashMapBuilder[int, int](types.IntHash)
+	for i := 0; i < 1000; i++ {
+		b.Put(i, i*i)
+	}
+	assert.True(t, b.Remove(10))
+	assert.False(t, b.Remove(10))
+
+	m := b.Build()
+	assert.Equal(t, 999, m.Size())
+	val, ok := m.Get(30)
+	assert.True(t, ok)
+	assert.Equal(t, 900, val)
+
+	// the map must not be affected by further changes to the builder
+	b.Put(30, 0)
+	b.Remove(31)
+	val, _ = m.Get(30)
+	assert.Equal(t, 900, val)
+	assert.True(t, m.ContainsKey(31))
+	assert.Equal(t, 998, b.Build().Size())
+}
+
+func TestImmutableHashMap_ToBuilderLeavesMapUntouched(t *testing.T) {
+	m := MakeImmutableHashMap[int, int](types.IntHash).Put(1, 1).Put(2, 2)
+	b := m.ToBuilder()
+	b.Put(1, 10)
+	b.Remove(2)
+
+	val, _ := m.Get(1)
+	assert.Equal(t, 1, val)
+	assert.Equal(t, 2, m.Size())
+	assert.Equal(t, 1, b.Build().Size())
+}
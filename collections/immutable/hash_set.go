@@ -0,0 +1,120 @@
+package immutable
+
+// ImmutableHashSet is a persistent set implementation using an ImmutableHashMap.
+//
+// Add and Remove return a new version of the set sharing most of its structure with the previous one.
+// It makes no guarantees on ordering of its elements.
+//
+// It's performance characteristics are:
+//
+// - Add: O(log32 n)
+//
+// - Remove: O(log32 n)
+//
+// - Contains: O(log32 n)
+type ImmutableHashSet[K any] struct {
+	innerMap *ImmutableHashMap[K, struct{}]
+}
+
+// MakeImmutableHashSet creates a new, empty, ImmutableHashSet
+func MakeImmutableHashSet[K any](h func(K) int) *ImmutableHashSet[K] {
+	return &ImmutableHashSet[K]{innerMap: MakeImmutableHashMap[K, struct{}](h)}
+}
+
+// Add returns a new version of the set with the element added
+func (s *ImmutableHashSet[K]) Add(val K) *ImmutableHashSet[K] {
+	if s.innerMap.ContainsKey(val) {
+		return s
+	}
+	return &ImmutableHashSet[K]{innerMap: s.innerMap.Put(val, struct{}{})}
+}
+
+// Remove returns a new version of the set without the element.
+// If the element does not exist the set itself is returned.
+func (s *ImmutableHashSet[K]) Remove(val K) *ImmutableHashSet[K] {
+	innerMap := s.innerMap.Remove(val)
+	if innerMap == s.innerMap {
+		return s
+	}
+	return &ImmutableHashSet[K]{innerMap: innerMap}
+}
+
+// Contains returns true if the element exists in the set, otherwise it returns false
+func (s *ImmutableHashSet[K]) Contains(val K) bool {
+	return s.innerMap.ContainsKey(val)
+}
+
+// Size returns the number of elements in the set
+func (s *ImmutableHashSet[K]) Size() int {
+	return s.innerMap.Size()
+}
+
+// IsEmpty returns true if the set is empty, otherwise it returns false
+func (s *ImmutableHashSet[K]) IsEmpty() bool {
+	return s.innerMap.IsEmpty()
+}
+
+// IsNotEmpty returns true if the set is not empty, otherwise it returns false
+func (s *ImmutableHashSet[K]) IsNotEmpty() bool {
+	return s.innerMap.IsNotEmpty()
+}
+
+// Formatted returns a string representation of the set
+func (s *ImmutableHashSet[K]) Formatted() string {
+	return s.String()
+}
+
+// ToSlice returns a slice with the elements of the set
+func (s *ImmutableHashSet[K]) ToSlice() []K {
+	return s.innerMap.Keys()
+}
+
+// Equal returns true if both sets hold the same elements
+func (s *ImmutableHashSet[K]) Equal(other *ImmutableHashSet[K]) bool {
+	return s.innerMap.EqualFunc(other.innerMap, func(a, b struct{}) bool { return true })
+}
+
+// ToBuilder returns a builder initialised with the elements of the set, leaving the set itself untouched
+func (s *ImmutableHashSet[K]) ToBuilder() *ImmutableHashSetBuilder[K] {
+	return &ImmutableHashSetBuilder[K]{innerBuilder: s.innerMap.ToBuilder()}
+}
+
+// ----------------
+// Builder
+
+// ImmutableHashSetBuilder is the transient counterpart of ImmutableHashSet, meant for bulk construction.
+// It is not thread safe.
+type ImmutableHashSetBuilder[K any] struct {
+	innerBuilder *ImmutableHashMapBuilder[K, struct{}]
+}
+
+// MakeImmutableHashSetBuilder creates a new, empty, ImmutableHashSetBuilder
+func MakeImmutableHashSetBuilder[K any](h func(K) int) *ImmutableHashSetBuilder[K] {
+	return &ImmutableHashSetBuilder[K]{innerBuilder: MakeImmutableHashMapBuilder[K, struct{}](h)}
+}
+
+// Add adds a new element to the builder
+func (b *ImmutableHashSetBuilder[K]) Add(val K) {
+	b.innerBuilder.Put(val, struct{}{})
+}
+
+// Remove removes an element from the builder, returning true if it was found
+func (b *ImmutableHashSetBuilder[K]) Remove(val K) bool {
+	return b.innerBuilder.Remove(val)
+}
+
+// Contains returns true if the element exists in the builder, otherwise it returns false
+func (b *ImmutableHashSetBuilder[K]) Contains(val K) bool {
+	_, ok := b.innerBuilder.Get(val)
+	return ok
+}
+
+// Size returns the number of elements in the builder
+func (b *ImmutableHashSetBuilder[K]) Size() int {
+	return b.innerBuilder.Size()
+}
+
+// Build returns an ImmutableHashSet with the elements of the builder
+func (b *ImmutableHashSetBuilder[K]) Build() *ImmutableHashSet[K] {
+	return &ImmutableHashSet[K]{innerMap: b.innerBuilder.Build()}
+}
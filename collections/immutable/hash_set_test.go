@@ -0,0 +1,47 @@
+package immutable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestImmutableHashSet_Add(t *testing.T) {
+	s := MakeImmutableHashSet[string](types.StringHash)
+	s1 := s.Add("a").Add("b")
+
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 2, s1.Size())
+	assert.True(t, s1.Contains("a"))
+	assert.Same(t, s1, s1.Add("a"))
+}
+
+func TestImmutableHashSet_Remove(t *testing.T) {
+	s1 := MakeImmutableHashSet[string](types.StringHash).Add("a").Add("b")
+	s2 := s1.Remove("a")
+
+	assert.False(t, s2.Contains("a"))
+	assert.True(t, s1.Contains("a"))
+	assert.Same(t, s2, s2.Remove("c"))
+}
+
+func TestImmutableHashSet_Equal(t *testing.T) {
+	s1 := MakeImmutableHashSet[string](types.StringHash).Add("a").Add("b")
+	s2 := MakeImmutableHashSet[string](types.StringHash).Add("b").Add("a")
+
+	assert.True(t, s1.Equal(s2))
+	assert.False(t, s1.Equal(s2.Add("c")))
+}
+
+func TestImmutableHashSetBuilder_Build(t *testing.T) {
+	b := MakeImmutableHashSetBuilder[int](types.IntHash)
+	for i := 0; i < 100; i++ {
+		b.Add(i)
+	}
+	b.Remove(50)
+
+	s := b.Build()
+	assert.Equal(t, 99, s.Size())
+	assert.False(t, s.Contains(50))
+	assert.ElementsMatch(t, s.ToSlice(), s.ToBuilder().Build().ToSlice())
+}
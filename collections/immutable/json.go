@@ -0,0 +1,205 @@
+package immutable
+
+import (
+	"encoding/json"
+	"fmt"
+	"utils-generics/collections/dict"
+)
+
+// File: json.go
+// JSON encoding of the persistent collections, using the same formats as their mutable counterparts.
+//
+// UnmarshalJSON has to modify the receiver in place, so it is only allowed into an empty collection that nothing
+// else holds yet, typically one just created with its Make function: hashers and comparators cannot be decoded.
+// An empty collection is easily shared though, e.g. kept as a template, so the maps and sets also offer DecodeJSON,
+// which returns a new collection and leaves the receiver untouched.
+
+func errNotConstructed(name string) error {
+	return fmt.Errorf("immutable: cannot unmarshal JSON into a %s that was not created with a Make function", name)
+}
+
+func errNotEmpty(name string) error {
+	return fmt.Errorf("immutable: cannot unmarshal JSON into a non-empty %s", name)
+}
+
+// marshalValues encodes the values as a JSON array, never as null
+func marshalValues[T any](values []T) ([]byte, error) {
+	if values == nil {
+		values = []T{}
+	}
+	return json.Marshal(values)
+}
+
+// MarshalJSON encodes the map as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise
+func (m *ImmutableHashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return dict.MarshalEntries(m.Entries())
+}
+
+// DecodeJSON returns a new version of the map with the entries decoded from the JSON put in it,
+// leaving the map untouched
+func (m *ImmutableHashMap[K, V]) DecodeJSON(data []byte) (*ImmutableHashMap[K, V], error) {
+	if m.hasher == nil {
+		return nil, errNotConstructed("ImmutableHashMap")
+	}
+	entries, err := dict.UnmarshalEntries[K, V](data)
+	if err != nil {
+		return nil, err
+	}
+
+	b := m.ToBuilder()
+	for _, entry := range entries {
+		b.Put(entry.Key, entry.Val)
+	}
+	return b.Build(), nil
+}
+
+// UnmarshalJSON decodes the entries into an empty map created with MakeImmutableHashMap.
+// It overwrites the map in place, which must not be held by anything else, see DecodeJSON otherwise.
+func (m *ImmutableHashMap[K, V]) UnmarshalJSON(data []byte) error {
+	if m.hasher != nil && m.size != 0 {
+		return errNotEmpty("ImmutableHashMap")
+	}
+	decoded, err := m.DecodeJSON(data)
+	if err != nil {
+		return err
+	}
+	*m = *decoded
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array
+func (s *ImmutableHashSet[K]) MarshalJSON() ([]byte, error) {
+	return marshalValues(s.ToSlice())
+}
+
+// DecodeJSON returns a new version of the set with the elements of a JSON array added to it,
+// leaving the set untouched
+func (s *ImmutableHashSet[K]) DecodeJSON(data []byte) (*ImmutableHashSet[K], error) {
+	if s.innerMap == nil {
+		return nil, errNotConstructed("ImmutableHashSet")
+	}
+	var elements []K
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return nil, err
+	}
+
+	b := s.ToBuilder()
+	for _, val := range elements {
+		b.Add(val)
+	}
+	return b.Build(), nil
+}
+
+// UnmarshalJSON decodes the elements of a JSON array into an empty set created with MakeImmutableHashSet.
+// It overwrites the set in place, which must not be held by anything else, see DecodeJSON otherwise.
+func (s *ImmutableHashSet[K]) UnmarshalJSON(data []byte) error {
+	if s.innerMap != nil && s.innerMap.size != 0 {
+		return errNotEmpty("ImmutableHashSet")
+	}
+	decoded, err := s.DecodeJSON(data)
+	if err != nil {
+		return err
+	}
+	*s = *decoded
+	return nil
+}
+
+// MarshalJSON encodes the map in key order, as a JSON object if the keys are string-like,
+// and as an array of {"key": .., "val": ..} objects otherwise
+func (m *ImmutableTreeMap[K, V]) MarshalJSON() ([]byte, error) {
+	return dict.MarshalEntries(m.Entries())
+}
+
+// DecodeJSON returns a new version of the map with the entries decoded from the JSON put in it,
+// leaving the map untouched
+func (m *ImmutableTreeMap[K, V]) DecodeJSON(data []byte) (*ImmutableTreeMap[K, V], error) {
+	if m.comparator == nil {
+		return nil, errNotConstructed("ImmutableTreeMap")
+	}
+	entries, err := dict.UnmarshalEntries[K, V](data)
+	if err != nil {
+		return nil, err
+	}
+
+	built := m
+	for _, entry := range entries {
+		built = built.Put(entry.Key, entry.Val)
+	}
+	return built, nil
+}
+
+// UnmarshalJSON decodes the entries into an empty map created with MakeImmutableTreeMap.
+// It overwrites the map in place, which must not be held by anything else, see DecodeJSON otherwise.
+func (m *ImmutableTreeMap[K, V]) UnmarshalJSON(data []byte) error {
+	if m.root != nil {
+		return errNotEmpty("ImmutableTreeMap")
+	}
+	decoded, err := m.DecodeJSON(data)
+	if err != nil {
+		return err
+	}
+	*m = *decoded
+	return nil
+}
+
+// MarshalJSON encodes the list as a JSON array
+func (l *ImmutableList[T]) MarshalJSON() ([]byte, error) {
+	return marshalValues(l.ToSlice())
+}
+
+// UnmarshalJSON decodes the values of a JSON array into an empty list
+func (l *ImmutableList[T]) UnmarshalJSON(data []byte) error {
+	if l.size != 0 {
+		return errNotEmpty("ImmutableList")
+	}
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*l = *MakeImmutableList(values...)
+	return nil
+}
+
+// MarshalJSON encodes the vector as a JSON array
+func (v *Vector[T]) MarshalJSON() ([]byte, error) {
+	return marshalValues(v.ToSlice())
+}
+
+// UnmarshalJSON decodes the values of a JSON array into an empty vector
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	if v.size != 0 {
+		return errNotEmpty("Vector")
+	}
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*v = *MakeVector(values...)
+	return nil
+}
+
+// MarshalJSON encodes the stack as a JSON array, from the bottom to the top of the stack,
+// so that pushing the values in order rebuilds it
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	if s.values == nil {
+		return []byte("[]"), nil
+	}
+	return marshalValues(s.values.Reverse().ToSlice())
+}
+
+// UnmarshalJSON decodes the values of a JSON array into an empty stack, the last value being the top
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	if s.values != nil && s.values.size != 0 {
+		return errNotEmpty("Stack")
+	}
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	s.values = MakeImmutableList(values...).Reverse()
+	return nil
+}
@@ -0,0 +1,115 @@
+package immutable
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestImmutableHashMap_JSON(t *testing.T) {
+	m := MakeImmutableHashMap[string, int](types.StringHash).Put("a", 1).Put("b", 2)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"a": 1, "b": 2}`, string(data))
+
+	decoded := MakeImmutableHashMap[string, int](types.StringHash)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.True(t, m.Equal(decoded))
+
+	assert.Error(t, json.Unmarshal(data, m))
+	assert.Error(t, json.Unmarshal(data, &ImmutableHashMap[string, int]{}))
+}
+
+func TestImmutableHashSet_JSON(t *testing.T) {
+	s := MakeImmutableHashSet[int](types.IntHash).Add(1).Add(2)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	decoded := MakeImmutableHashSet[int](types.IntHash)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.True(t, s.Equal(decoded))
+}
+
+func TestImmutableTreeMap_JSON(t *testing.T) {
+	m := MakeImmutableTreeMap[int, string](types.IntComparator).Put(2, "two").Put(1, "one")
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"key":1,"val":"one"},{"key":2,"val":"two"}]`, string(data))
+
+	decoded := MakeImmutableTreeMap[int, string](types.IntComparator)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+	assert.Error(t, json.Unmarshal(data, decoded))
+}
+
+func TestImmutable_DecodeJSONLeavesReceiver(t *testing.T) {
+	// an empty map kept as a template is shared by every map decoded from it
+	hashTemplate := MakeImmutableHashMap[string, int](types.StringHash)
+	hashMap, err := hashTemplate.DecodeJSON([]byte(`{"a": 1}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, hashMap.Size())
+	assert.True(t, hashTemplate.IsEmpty())
+
+	treeTemplate := MakeImmutableTreeMap[int, string](types.IntComparator)
+	treeMap, err := treeTemplate.DecodeJSON([]byte(`[{"key": 1, "val": "one"}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, treeMap.Keys())
+	assert.True(t, treeTemplate.IsEmpty())
+
+	setTemplate := MakeImmutableHashSet[int](types.IntHash)
+	set, err := setTemplate.DecodeJSON([]byte(`[1, 2]`))
+	assert.NoError(t, err)
+	assert.True(t, set.Contains(2))
+	assert.True(t, setTemplate.IsEmpty())
+
+	_, err = (&ImmutableHashSet[int]{}).DecodeJSON([]byte(`[1]`))
+	assert.Error(t, err)
+	_, err = hashTemplate.DecodeJSON([]byte(`[`))
+	assert.Error(t, err)
+}
+
+func TestImmutableList_JSON(t *testing.T) {
+	l := MakeImmutableList(1, 2, 3)
+
+	data, err := json.Marshal(l)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2,3]`, string(data))
+
+	var decoded ImmutableList[int]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, []int{1, 2, 3}, decoded.ToSlice())
+}
+
+func TestVector_JSON(t *testing.T) {
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = i
+	}
+	v := MakeVector(values...)
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	var decoded Vector[int]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, values, decoded.ToSlice())
+	decoded.Append(100)
+}
+
+func TestStack_JSON_BottomToTop(t *testing.T) {
+	s := MakeStack[string]().Push("bottom").Push("top")
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `["bottom","top"]`, string(data))
+
+	var decoded Stack[string]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	top, _ := decoded.Peek()
+	assert.Equal(t, "top", top)
+	assert.Equal(t, 2, decoded.Size())
+}
@@ -0,0 +1,222 @@
+package immutable
+
+import "utils-generics/collections/list"
+
+type consCell[T any] struct {
+	val  T
+	next *consCell[T]
+}
+
+// ImmutableList is a persistent singly linked (cons) list.
+//
+// It is never modified in place: every operation returns a new version of the list that shares
+// as much of its cells with the previous one as possible -- the whole list in the case of Prepend and Tail.
+//
+// It's performance characteristics are:
+//
+// - Prepend: O(1)
+//
+// - Tail: O(1)
+//
+// - Get: O(n)
+//
+// - Append: O(n)
+type ImmutableList[T any] struct {
+	head *consCell[T]
+	size int
+}
+
+// MakeImmutableList creates a new ImmutableList holding the values in the given order
+func MakeImmutableList[T any](values ...T) *ImmutableList[T] {
+	var head *consCell[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		head = &consCell[T]{val: values[i], next: head}
+	}
+	return &ImmutableList[T]{head: head, size: len(values)}
+}
+
+// MakeImmutableListFrom creates a new ImmutableList holding the values of a mutable list, e.g. a LinkedList
+func MakeImmutableListFrom[T any](l list.List[T]) *ImmutableList[T] {
+	return MakeImmutableList(listValues(l)...)
+}
+
+// listValues returns the values of the list in order, in a single pass for lists that have a ToSlice method
+// such as LinkedList, and through Get otherwise
+func listValues[T any](l list.List[T]) []T {
+	if s, ok := l.(interface{ ToSlice() []T }); ok {
+		return s.ToSlice()
+	}
+	values := make([]T, 0, l.Size())
+	for i := 0; i < l.Size(); i++ {
+		val, _ := l.Get(i)
+		values = append(values, val)
+	}
+	return values
+}
+
+// Prepend returns a new version of the list with the value added to the front, sharing every cell of this list
+func (l *ImmutableList[T]) Prepend(val T) *ImmutableList[T] {
+	return checked(&ImmutableList[T]{head: &consCell[T]{val: val, next: l.head}, size: l.size + 1})
+}
+
+// Head returns the first value of the list and true, or false if the list is empty
+func (l *ImmutableList[T]) Head() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+	return l.head.val, true
+}
+
+// Tail returns the list without its first value, sharing every cell of this list.
+// The tail of an empty list is the list itself.
+func (l *ImmutableList[T]) Tail() *ImmutableList[T] {
+	if l.head == nil {
+		return l
+	}
+	return checked(&ImmutableList[T]{head: l.head.next, size: l.size - 1})
+}
+
+// Get returns the value at the given index and true if the index is valid, otherwise the zero value and false
+func (l *ImmutableList[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= l.size {
+		var zero T
+		return zero, false
+	}
+
+	current := l.head
+	for i := 0; i < index; i++ {
+		current = current.next
+	}
+	return current.val, true
+}
+
+// Append returns a new version of the list with the value added to the end.
+// Every cell has to be copied, so prefer Prepend or Vector when appending often.
+func (l *ImmutableList[T]) Append(val T) *ImmutableList[T] {
+	return l.Concat(MakeImmutableList(val))
+}
+
+// Set returns a new version of the list with the value at the given index replaced, sharing the cells after it.
+// The list itself is returned if the index is out of bounds.
+func (l *ImmutableList[T]) Set(index int, val T) *ImmutableList[T] {
+	if index < 0 || index >= l.size {
+		return l
+	}
+
+	prefix, rest := l.copyPrefix(index)
+	cell := &consCell[T]{val: val, next: rest.next}
+	return checked(&ImmutableList[T]{head: linkPrefix(prefix, cell), size: l.size})
+}
+
+// Slice returns the values between from (inclusive) and to (exclusive), clamped to the bounds of the list.
+// A suffix of the list shares all its cells, any other slice copies its values.
+func (l *ImmutableList[T]) Slice(from, to int) *ImmutableList[T] {
+	if from < 0 {
+		from = 0
+	}
+	if to > l.size {
+		to = l.size
+	}
+	if from >= to {
+		return &ImmutableList[T]{}
+	}
+
+	current := l.head
+	for i := 0; i < from; i++ {
+		current = current.next
+	}
+	if to == l.size {
+		return checked(&ImmutableList[T]{head: current, size: to - from})
+	}
+
+	suffix := &ImmutableList[T]{head: current, size: l.size - from}
+	prefix, _ := suffix.copyPrefix(to - from)
+	return checked(&ImmutableList[T]{head: linkPrefix[T](prefix, nil), size: to - from})
+}
+
+// Concat returns a new list with the values of this list followed by the values of the other one.
+// The cells of the other list are shared, the cells of this list are copied.
+func (l *ImmutableList[T]) Concat(other *ImmutableList[T]) *ImmutableList[T] {
+	if l.head == nil {
+		return other
+	}
+
+	prefix, _ := l.copyPrefix(l.size)
+	return checked(&ImmutableList[T]{head: linkPrefix(prefix, other.head), size: l.size + other.size})
+}
+
+// copyPrefix copies the first n cells, returning the copies along with the first cell that was not copied
+func (l *ImmutableList[T]) copyPrefix(n int) ([]*consCell[T], *consCell[T]) {
+	prefix := make([]*consCell[T], 0, n)
+	current := l.head
+	for i := 0; i < n; i++ {
+		prefix = append(prefix, &consCell[T]{val: current.val})
+		current = current.next
+	}
+	return prefix, current
+}
+
+func linkPrefix[T any](prefix []*consCell[T], rest *consCell[T]) *consCell[T] {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		prefix[i].next = rest
+		rest = prefix[i]
+	}
+	return rest
+}
+
+// Reverse returns a new list with the values in reverse order
+func (l *ImmutableList[T]) Reverse() *ImmutableList[T] {
+	var head *consCell[T]
+	for current := l.head; current != nil; current = current.next {
+		head = &consCell[T]{val: current.val, next: head}
+	}
+	return checked(&ImmutableList[T]{head: head, size: l.size})
+}
+
+// Size returns the number of values in the list
+func (l *ImmutableList[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty returns true if the list is empty
+func (l *ImmutableList[T]) IsEmpty() bool {
+	return l.head == nil
+}
+
+// IsNotEmpty returns true if the list is not empty
+func (l *ImmutableList[T]) IsNotEmpty() bool {
+	return l.head != nil
+}
+
+// Formatted returns a string representation of the list
+func (l *ImmutableList[T]) Formatted() string {
+	return l.String()
+}
+
+// ToSlice returns a slice with the values of the list, in order
+func (l *ImmutableList[T]) ToSlice() []T {
+	values := make([]T, 0, l.size)
+	for current := l.head; current != nil; current = current.next {
+		values = append(values, current.val)
+	}
+	return values
+}
+
+// ToLinkedList returns a mutable LinkedList with the values of the list
+func (l *ImmutableList[T]) ToLinkedList() *list.LinkedList[T] {
+	linked := list.MakeLinkedList[T]()
+	for current := l.head; current != nil; current = current.next {
+		linked.Add(current.val)
+	}
+	return linked
+}
+
+// ToDoubleLinkedList returns a mutable DoubleLinkedList with the values of the list
+func (l *ImmutableList[T]) ToDoubleLinkedList() *list.DoubleLinkedList[T] {
+	linked := list.MakeDoubleLinkedList[T]()
+	for current := l.head; current != nil; current = current.next {
+		linked.Add(current.val)
+	}
+	return linked
+}
@@ -0,0 +1,123 @@
+package immutable
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/list"
+)
+
+func TestImmutableList_Prepend(t *testing.T) {
+	l := MakeImmutableList(2, 3)
+	l1 := l.Prepend(1)
+
+	assert.Equal(t, []int{1, 2, 3}, l1.ToSlice())
+	assert.Equal(t, []int{2, 3}, l.ToSlice())
+	assert.Same(t, l.head, l1.Tail().head)
+}
+
+func TestImmutableList_HeadAndTail(t *testing.T) {
+	l := MakeImmutableList(1, 2)
+
+	val, ok := l.Head()
+	assert.True(t, ok)
+	assert.Equal(t, 1, val)
+	assert.Equal(t, []int{2}, l.Tail().ToSlice())
+
+	empty := MakeImmutableList[int]()
+	_, ok = empty.Head()
+	assert.False(t, ok)
+	assert.Same(t, empty, empty.Tail())
+}
+
+func TestImmutableList_Get(t *testing.T) {
+	l := MakeImmutableList("a", "b", "c")
+
+	val, ok := l.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, "c", val)
+
+	_, ok = l.Get(3)
+	assert.False(t, ok)
+	_, ok = l.Get(-1)
+	assert.False(t, ok)
+}
+
+func TestImmutableList_AppendAndSet(t *testing.T) {
+	l := MakeImmutableList(1, 2, 3)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, l.Append(4).ToSlice())
+
+	set := l.Set(1, 20)
+	assert.Equal(t, []int{1, 20, 3}, set.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice())
+	assert.Same(t, l.head.next.next, set.head.next.next)
+	assert.Same(t, l, l.Set(5, 0))
+}
+
+func TestImmutableList_Slice(t *testing.T) {
+	l := MakeImmutableList(0, 1, 2, 3, 4)
+
+	assert.Equal(t, []int{1, 2}, l.Slice(1, 3).ToSlice())
+	assert.Equal(t, 2, l.Slice(1, 3).Size())
+	assert.Equal(t, []int{3, 4}, l.Slice(3, 10).ToSlice())
+	assert.Same(t, l.head.next.next.next, l.Slice(3, 5).head)
+	assert.True(t, l.Slice(3, 2).IsEmpty())
+}
+
+func TestImmutableList_Concat(t *testing.T) {
+	a := MakeImmutableList(1, 2)
+	b := MakeImmutableList(3, 4)
+	c := a.Concat(b)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, c.ToSlice())
+	assert.Equal(t, 4, c.Size())
+	assert.Same(t, b.head, c.head.next.next)
+	assert.Equal(t, []int{4, 3, 2, 1}, c.Reverse().ToSlice())
+}
+
+func TestImmutableList_Formatted(t *testing.T) {
+	assert.Equal(t, "[1, 2]", MakeImmutableList(1, 2).Formatted())
+	assert.Equal(t, "[]", MakeImmutableList[int]().Formatted())
+}
+
+func TestImmutableList_LinkedListConversions(t *testing.T) {
+	linked := list.MakeLinkedList[int]()
+	linked.Add(1)
+	linked.Add(2)
+
+	l := MakeImmutableListFrom[int](linked)
+	assert.Equal(t, []int{1, 2}, l.ToSlice())
+
+	linked.Add(3)
+	assert.Equal(t, 2, l.Size())
+
+	assert.Equal(t, []int{1, 2}, l.ToLinkedList().ToSlice())
+	assert.Equal(t, []int{1, 2}, l.ToDoubleLinkedList().ToSlice())
+	assert.Equal(t, []int{1, 2}, MakeImmutableListFrom[int](l.ToDoubleLinkedList()).ToSlice())
+}
+
+// sliceList is a list.List with no ToSlice method, as an implementation outside this module could be
+type sliceList []int
+
+func (l *sliceList) Add(val int)       { *l = append(*l, val) }
+func (l *sliceList) Clear()            { *l = nil }
+func (l *sliceList) Size() int         { return len(*l) }
+func (l *sliceList) IsEmpty() bool     { return len(*l) == 0 }
+func (l *sliceList) IsNotEmpty() bool  { return len(*l) > 0 }
+func (l *sliceList) Formatted() string { return fmt.Sprint(*l) }
+func (l *sliceList) Remove(int) bool   { panic("not used") }
+func (l *sliceList) Contains(int) bool { panic("not used") }
+func (l *sliceList) Get(index int) (int, bool) {
+	if index < 0 || index >= len(*l) {
+		return 0, false
+	}
+	return (*l)[index], true
+}
+
+func TestImmutableList_FromListWithoutToSlice(t *testing.T) {
+	var l list.List[int] = &sliceList{3, 1, 2}
+
+	assert.Equal(t, []int{3, 1, 2}, MakeImmutableListFrom(l).ToSlice())
+	assert.Equal(t, []int{3, 1, 2}, MakeVectorFrom(l).ToSlice())
+}
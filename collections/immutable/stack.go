@@ -0,0 +1,62 @@
+package immutable
+
+// Stack is a persistent LIFO stack, backed by an ImmutableList.
+//
+// Push and Pop return a new version of the stack, sharing every value with the previous one,
+// which makes it a good fit for undo histories. It satisfies list.ReadOnlyStack.
+//
+// It's performance characteristics are:
+//
+// - Push: O(1)
+//
+// - Pop: O(1)
+//
+// - Peek: O(1)
+type Stack[T any] struct {
+	values *ImmutableList[T]
+}
+
+// MakeStack creates a new, empty, Stack
+func MakeStack[T any]() *Stack[T] {
+	return &Stack[T]{values: MakeImmutableList[T]()}
+}
+
+// Push returns a new version of the stack with the value on top
+func (s *Stack[T]) Push(val T) *Stack[T] {
+	return &Stack[T]{values: s.values.Prepend(val)}
+}
+
+// Pop returns the value on top of the stack along with the stack without it and true,
+// or false and the stack itself if the stack is empty
+func (s *Stack[T]) Pop() (T, *Stack[T], bool) {
+	val, ok := s.values.Head()
+	if !ok {
+		return val, s, false
+	}
+	return val, &Stack[T]{values: s.values.Tail()}, true
+}
+
+// Peek returns the value on top of the stack and true, or false if the stack is empty
+func (s *Stack[T]) Peek() (T, bool) {
+	return s.values.Head()
+}
+
+// Size returns the number of values in the stack
+func (s *Stack[T]) Size() int {
+	return s.values.Size()
+}
+
+// IsEmpty returns true if the stack is empty
+func (s *Stack[T]) IsEmpty() bool {
+	return s.values.IsEmpty()
+}
+
+// IsNotEmpty returns true if the stack is not empty
+func (s *Stack[T]) IsNotEmpty() bool {
+	return s.values.IsNotEmpty()
+}
+
+// Formatted returns a string representation of the stack, from the top to the bottom
+func (s *Stack[T]) Formatted() string {
+	return s.String()
+}
@@ -0,0 +1,43 @@
+package immutable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/list"
+)
+
+func TestStack_Push(t *testing.T) {
+	s := MakeStack[int]()
+	s1 := s.Push(1)
+	s2 := s1.Push(2)
+
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 1, s1.Size())
+	assert.Equal(t, 2, s2.Size())
+
+	val, ok := s2.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+}
+
+func TestStack_Pop(t *testing.T) {
+	s := MakeStack[int]().Push(1).Push(2)
+
+	val, rest, ok := s.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+	assert.Equal(t, 1, rest.Size())
+	assert.Equal(t, 2, s.Size())
+
+	_, rest, _ = rest.Pop()
+	_, empty, ok := rest.Pop()
+	assert.False(t, ok)
+	assert.Same(t, rest, empty)
+}
+
+func TestStack_IsReadOnlyStack(t *testing.T) {
+	var s list.ReadOnlyStack[string] = MakeStack[string]().Push("a").Push("b")
+
+	assert.True(t, s.IsNotEmpty())
+	assert.Equal(t, "[b, a]", s.Formatted())
+}
@@ -0,0 +1,488 @@
+package immutable
+
+import (
+	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
+)
+
+// weight balance parameters, see "Balancing weight-balanced trees" (Hirai & Yamamoto) for why (3, 2) is sound
+const (
+	treeDelta = 3
+	treeRatio = 2
+)
+
+type treeNode[K any, V any] struct {
+	dict.Entry[K, V]
+	size  int
+	left  *treeNode[K, V]
+	right *treeNode[K, V]
+}
+
+func treeSize[K any, V any](node *treeNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func newTreeNode[K any, V any](e dict.Entry[K, V], left, right *treeNode[K, V]) *treeNode[K, V] {
+	return &treeNode[K, V]{Entry: e, size: treeSize(left) + treeSize(right) + 1, left: left, right: right}
+}
+
+// ImmutableTreeMap is a persistent sorted map implementation using a weight-balanced binary tree.
+//
+// It stores its keys in natural order i.e. ints are stored in ascending order, strings are stored in alphabetical order.
+// It is never modified in place: Put and Remove copy the path from the root to the changed node and return a new
+// version of the map, sharing every other node with the previous one. This makes it safe to share between goroutines
+// and cheap to compare versions with Diff.
+//
+// It's performance characteristics are:
+//
+// - Put: O(log n)
+//
+// - Get: O(log n)
+//
+// - Remove: O(log n)
+type ImmutableTreeMap[K any, V any] struct {
+	root       *treeNode[K, V]
+	comparator func(a, b K) int
+}
+
+// MakeImmutableTreeMap creates a new, empty, ImmutableTreeMap
+func MakeImmutableTreeMap[K any, V any](c func(a, b K) int) *ImmutableTreeMap[K, V] {
+	return &ImmutableTreeMap[K, V]{comparator: c}
+}
+
+// Put returns a new version of the map with the entry added.
+// If an entry with the key already exists its value is replaced in the new version.
+//
+// Time complexity: O(log n)
+func (m *ImmutableTreeMap[K, V]) Put(key K, val V) *ImmutableTreeMap[K, V] {
+	return checked(&ImmutableTreeMap[K, V]{root: m.put(m.root, dict.Entry[K, V]{Key: key, Val: val}), comparator: m.comparator})
+}
+
+func (m *ImmutableTreeMap[K, V]) put(node *treeNode[K, V], e dict.Entry[K, V]) *treeNode[K, V] {
+	if node == nil {
+		return newTreeNode[K, V](e, nil, nil)
+	}
+
+	c := m.comparator(e.Key, node.Key)
+	if c < 0 {
+		return balance(node.Entry, m.put(node.left, e), node.right)
+	} else if c > 0 {
+		return balance(node.Entry, node.left, m.put(node.right, e))
+	}
+	return &treeNode[K, V]{Entry: e, size: node.size, left: node.left, right: node.right}
+}
+
+// Remove returns a new version of the map without the entry identified by the key.
+// If the key does not exist the map itself is returned.
+//
+// Time complexity: O(log n)
+func (m *ImmutableTreeMap[K, V]) Remove(key K) *ImmutableTreeMap[K, V] {
+	root, removed := m.remove(m.root, key)
+	if !removed {
+		return m
+	}
+	return checked(&ImmutableTreeMap[K, V]{root: root, comparator: m.comparator})
+}
+
+func (m *ImmutableTreeMap[K, V]) remove(node *treeNode[K, V], key K) (*treeNode[K, V], bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	c := m.comparator(key, node.Key)
+	if c < 0 {
+		left, removed := m.remove(node.left, key)
+		if !removed {
+			return node, false
+		}
+		return balance(node.Entry, left, node.right), true
+	} else if c > 0 {
+		right, removed := m.remove(node.right, key)
+		if !removed {
+			return node, false
+		}
+		return balance(node.Entry, node.left, right), true
+	}
+	return glue(node.left, node.right), true
+}
+
+// glue joins two balanced subtrees whose keys are all in order, taking the new root from the heavier one
+func glue[K any, V any](left, right *treeNode[K, V]) *treeNode[K, V] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+
+	if left.size > right.size {
+		rest, maxEntry := removeMax(left)
+		return balance(maxEntry, rest, right)
+	}
+	rest, minEntry := removeMin(right)
+	return balance(minEntry, left, rest)
+}
+
+func removeMin[K any, V any](node *treeNode[K, V]) (*treeNode[K, V], dict.Entry[K, V]) {
+	if node.left == nil {
+		return node.right, node.Entry
+	}
+	left, minEntry := removeMin(node.left)
+	return balance(node.Entry, left, node.right), minEntry
+}
+
+func removeMax[K any, V any](node *treeNode[K, V]) (*treeNode[K, V], dict.Entry[K, V]) {
+	if node.right == nil {
+		return node.left, node.Entry
+	}
+	right, maxEntry := removeMax(node.right)
+	return balance(node.Entry, node.left, right), maxEntry
+}
+
+// balance builds a node out of subtrees that were balanced before a single insertion or deletion,
+// rotating them if the weight of one side got too large for the other
+func balance[K any, V any](e dict.Entry[K, V], left, right *treeNode[K, V]) *treeNode[K, V] {
+	sl, sr := treeSize(left), treeSize(right)
+	if sl+sr <= 1 {
+		return newTreeNode(e, left, right)
+	}
+
+	if sr > treeDelta*sl {
+		if treeSize(right.left) < treeRatio*treeSize(right.right) {
+			// single left rotation
+			return newTreeNode(right.Entry, newTreeNode(e, left, right.left), right.right)
+		}
+		// double left rotation
+		pivot := right.left
+		return newTreeNode(pivot.Entry, newTreeNode(e, left, pivot.left), newTreeNode(right.Entry, pivot.right, right.right))
+	}
+
+	if sl > treeDelta*sr {
+		if treeSize(left.right) < treeRatio*treeSize(left.left) {
+			// single right rotation
+			return newTreeNode(left.Entry, left.left, newTreeNode(e, left.right, right))
+		}
+		// double right rotation
+		pivot := left.right
+		return newTreeNode(pivot.Entry, newTreeNode(left.Entry, left.left, pivot.left), newTreeNode(e, pivot.right, right))
+	}
+
+	return newTreeNode(e, left, right)
+}
+
+// Get returns the value associated with the provided key and true if the key was found and false if otherwise
+//
+// Time complexity: O(log n)
+func (m *ImmutableTreeMap[K, V]) Get(key K) (V, bool) {
+	node := m.root
+	for node != nil {
+		c := m.comparator(key, node.Key)
+		if c < 0 {
+			node = node.left
+		} else if c > 0 {
+			node = node.right
+		} else {
+			return node.Val, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// ContainsKey returns true if the map contains an entry with the provided key and false if otherwise
+func (m *ImmutableTreeMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Size returns the number of entries in the map
+//
+// Time complexity: O(1)
+func (m *ImmutableTreeMap[K, V]) Size() int {
+	return treeSize(m.root)
+}
+
+// IsEmpty returns true if the map is empty and false if otherwise
+func (m *ImmutableTreeMap[K, V]) IsEmpty() bool {
+	return m.root == nil
+}
+
+// IsNotEmpty returns true if the map is not empty and false if otherwise
+func (m *ImmutableTreeMap[K, V]) IsNotEmpty() bool {
+	return m.root != nil
+}
+
+// Formatted returns a string representation of the map, in key order
+func (m *ImmutableTreeMap[K, V]) Formatted() string {
+	return m.String()
+}
+
+// Entries returns a slice of all entries in the map, in key order
+func (m *ImmutableTreeMap[K, V]) Entries() []dict.Entry[K, V] {
+	entries := make([]dict.Entry[K, V], 0, m.Size())
+	m.ForEach(func(key K, val V) bool {
+		entries = append(entries, dict.Entry[K, V]{Key: key, Val: val})
+		return true
+	})
+	return entries
+}
+
+// Keys returns a slice of all keys in the map, in order
+func (m *ImmutableTreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Size())
+	m.ForEach(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns a slice of all values in the map, in key order
+func (m *ImmutableTreeMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Size())
+	m.ForEach(func(_ K, val V) bool {
+		values = append(values, val)
+		return true
+	})
+	return values
+}
+
+// ForEach visits every entry of the map in key order, stopping as soon as visit returns false
+func (m *ImmutableTreeMap[K, V]) ForEach(visit func(key K, val V) bool) {
+	forEachNode(m.root, visit)
+}
+
+func forEachNode[K any, V any](node *treeNode[K, V], visit func(key K, val V) bool) bool {
+	if node == nil {
+		return true
+	}
+	return forEachNode(node.left, visit) && visit(node.Key, node.Val) && forEachNode(node.right, visit)
+}
+
+// ---------------
+// OrderedMap methods
+
+// First returns the smallest key of the map
+func (m *ImmutableTreeMap[K, V]) First() K {
+	var zero K
+	node := m.root
+	if node == nil {
+		return zero
+	}
+	for node.left != nil {
+		node = node.left
+	}
+	return node.Key
+}
+
+// Last returns the greatest key of the map
+func (m *ImmutableTreeMap[K, V]) Last() K {
+	var zero K
+	node := m.root
+	if node == nil {
+		return zero
+	}
+	for node.right != nil {
+		node = node.right
+	}
+	return node.Key
+}
+
+// RemoveFirst returns a new version of the map without its smallest key
+func (m *ImmutableTreeMap[K, V]) RemoveFirst() *ImmutableTreeMap[K, V] {
+	if m.root == nil {
+		return m
+	}
+	root, _ := removeMin(m.root)
+	return checked(&ImmutableTreeMap[K, V]{root: root, comparator: m.comparator})
+}
+
+// RemoveLast returns a new version of the map without its greatest key
+func (m *ImmutableTreeMap[K, V]) RemoveLast() *ImmutableTreeMap[K, V] {
+	if m.root == nil {
+		return m
+	}
+	root, _ := removeMax(m.root)
+	return checked(&ImmutableTreeMap[K, V]{root: root, comparator: m.comparator})
+}
+
+// ---------------
+// NavigableMap methods
+
+// Floor returns the entry with the greatest key less than or equal to the given key, if any
+func (m *ImmutableTreeMap[K, V]) Floor(key K) (dict.Entry[K, V], bool) {
+	return m.closest(key, true, true)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the given key, if any
+func (m *ImmutableTreeMap[K, V]) Ceiling(key K) (dict.Entry[K, V], bool) {
+	return m.closest(key, false, true)
+}
+
+// Lower returns the entry with the greatest key strictly less than the given key, if any
+func (m *ImmutableTreeMap[K, V]) Lower(key K) (dict.Entry[K, V], bool) {
+	return m.closest(key, true, false)
+}
+
+// Higher returns the entry with the smallest key strictly greater than the given key, if any
+func (m *ImmutableTreeMap[K, V]) Higher(key K) (dict.Entry[K, V], bool) {
+	return m.closest(key, false, false)
+}
+
+func (m *ImmutableTreeMap[K, V]) closest(key K, below bool, inclusive bool) (dict.Entry[K, V], bool) {
+	var candidate *treeNode[K, V]
+	node := m.root
+	for node != nil {
+		c := m.comparator(key, node.Key)
+		if c == 0 && inclusive {
+			return node.Entry, true
+		}
+
+		if below {
+			if c > 0 {
+				candidate = node
+				node = node.right
+			} else {
+				node = node.left
+			}
+		} else {
+			if c < 0 {
+				candidate = node
+				node = node.left
+			} else {
+				node = node.right
+			}
+		}
+	}
+
+	if candidate == nil {
+		return dict.Entry[K, V]{}, false
+	}
+	return candidate.Entry, true
+}
+
+// Range returns, in order, the entries whose keys are in the half-open interval [from, to)
+//
+// Time complexity: O(log n + m) where m is the number of entries returned
+func (m *ImmutableTreeMap[K, V]) Range(from, to K) []dict.Entry[K, V] {
+	var entries []dict.Entry[K, V]
+	m.ForEachInRange(from, to, func(key K, val V) bool {
+		entries = append(entries, dict.Entry[K, V]{Key: key, Val: val})
+		return true
+	})
+	return entries
+}
+
+// ForEachInRange visits, in order, the entries whose keys are in the half-open interval [from, to),
+// stopping as soon as visit returns false
+func (m *ImmutableTreeMap[K, V]) ForEachInRange(from, to K, visit func(key K, val V) bool) {
+	m.forEachInRange(m.root, from, to, visit)
+}
+
+func (m *ImmutableTreeMap[K, V]) forEachInRange(node *treeNode[K, V], from, to K, visit func(key K, val V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	afterFrom := m.comparator(node.Key, from) >= 0
+	beforeTo := m.comparator(node.Key, to) < 0
+	if afterFrom && !m.forEachInRange(node.left, from, to, visit) {
+		return false
+	}
+	if afterFrom && beforeTo && !visit(node.Key, node.Val) {
+		return false
+	}
+	if beforeTo {
+		return m.forEachInRange(node.right, from, to, visit)
+	}
+	return true
+}
+
+// ---------------
+// Diff
+
+// DiffKind tells how an entry changed between two versions of a map
+type DiffKind int
+
+const (
+	Added DiffKind = iota
+	Removed
+	Changed
+)
+
+// DiffEntry is a single difference between two versions of a map.
+// OldVal is unset for added entries and NewVal is unset for removed entries.
+type DiffEntry[K any, V any] struct {
+	Kind   DiffKind
+	Key    K
+	OldVal V
+	NewVal V
+}
+
+// Diff returns, in key order, the changes that turn this map into the other one, comparing values with
+// reflect.DeepEqual.
+//
+// Subtrees shared by both versions are skipped entirely, so diffing a map against a version derived from it
+// costs roughly O(d log n) for d changes instead of O(n).
+func (m *ImmutableTreeMap[K, V]) Diff(other *ImmutableTreeMap[K, V]) []DiffEntry[K, V] {
+	return m.DiffFunc(other, types.DeepEquals[V])
+}
+
+// DiffFunc is like Diff but compares values with the provided function
+func (m *ImmutableTreeMap[K, V]) DiffFunc(other *ImmutableTreeMap[K, V], valEquals func(a, b V) bool) []DiffEntry[K, V] {
+	var diff []DiffEntry[K, V]
+	m.diff(m.root, other.root, valEquals, &diff)
+	return diff
+}
+
+func (m *ImmutableTreeMap[K, V]) diff(a, b *treeNode[K, V], valEquals func(a, b V) bool, diff *[]DiffEntry[K, V]) {
+	if a == b {
+		return
+	}
+	if a == nil {
+		forEachNode(b, func(key K, val V) bool {
+			*diff = append(*diff, DiffEntry[K, V]{Kind: Added, Key: key, NewVal: val})
+			return true
+		})
+		return
+	}
+	if b == nil {
+		forEachNode(a, func(key K, val V) bool {
+			*diff = append(*diff, DiffEntry[K, V]{Kind: Removed, Key: key, OldVal: val})
+			return true
+		})
+		return
+	}
+
+	// when both trees share their root key the split hands back b's own subtrees, keeping them comparable by pointer
+	left, match, right := m.split(b, a.Key)
+	m.diff(a.left, left, valEquals, diff)
+	if match == nil {
+		*diff = append(*diff, DiffEntry[K, V]{Kind: Removed, Key: a.Key, OldVal: a.Val})
+	} else if !valEquals(a.Val, match.Val) {
+		*diff = append(*diff, DiffEntry[K, V]{Kind: Changed, Key: a.Key, OldVal: a.Val, NewVal: match.Val})
+	}
+	m.diff(a.right, right, valEquals, diff)
+}
+
+// split divides the tree into the nodes with keys lower and greater than the key, plus the node holding the key if any.
+// The halves are not rebalanced since they are only used for comparisons.
+func (m *ImmutableTreeMap[K, V]) split(node *treeNode[K, V], key K) (*treeNode[K, V], *treeNode[K, V], *treeNode[K, V]) {
+	if node == nil {
+		return nil, nil, nil
+	}
+
+	c := m.comparator(key, node.Key)
+	if c < 0 {
+		left, match, right := m.split(node.left, key)
+		return left, match, newTreeNode(node.Entry, right, node.right)
+	} else if c > 0 {
+		left, match, right := m.split(node.right, key)
+		return newTreeNode(node.Entry, node.left, left), match, right
+	}
+	return node.left, node, node.right
+}
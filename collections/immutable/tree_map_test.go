@@ -0,0 +1,182 @@
+package immutable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
+)
+
+func TestImmutableTreeMap_Put(t *testing.T) {
+	m := MakeImmutableTreeMap[string, int](types.StringComparator)
+	m1 := m.Put("b", 2).Put("a", 1)
+	m2 := m1.Put("c", 3)
+
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, []string{"a", "b"}, m1.Keys())
+	assert.Equal(t, []string{"a", "b", "c"}, m2.Keys())
+	assert.Equal(t, []int{1, 2, 3}, m2.Values())
+}
+
+func TestImmutableTreeMap_PutReplaces(t *testing.T) {
+	m1 := MakeImmutableTreeMap[string, int](types.StringComparator).Put("a", 1)
+	m2 := m1.Put("a", 10)
+
+	val, _ := m1.Get("a")
+	assert.Equal(t, 1, val)
+	val, _ = m2.Get("a")
+	assert.Equal(t, 10, val)
+	assert.Equal(t, 1, m2.Size())
+}
+
+func TestImmutableTreeMap_Remove(t *testing.T) {
+	m1 := MakeImmutableTreeMap[int, int](types.IntComparator).Put(1, 1).Put(2, 2).Put(3, 3)
+	m2 := m1.Remove(2)
+
+	assert.Equal(t, []int{1, 3}, m2.Keys())
+	assert.Equal(t, []int{1, 2, 3}, m1.Keys())
+	assert.Same(t, m2, m2.Remove(5))
+}
+
+func TestImmutableTreeMap_StaysBalanced(t *testing.T) {
+	m := MakeImmutableTreeMap[int, int](types.IntComparator)
+	for i := 0; i < 1<<12; i++ {
+		m = m.Put(i, i)
+	}
+	for i := 0; i < 1<<11; i++ {
+		m = m.Remove(i * 2)
+	}
+
+	assert.Equal(t, 1<<11, m.Size())
+	assert.LessOrEqual(t, height(m.root), 2*12)
+}
+
+func height[K any, V any](node *treeNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+	l, r := height(node.left), height(node.right)
+	if l > r {
+		return l + 1
+	}
+	return r + 1
+}
+
+func TestImmutableTreeMap_MatchesBuiltinMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := MakeImmutableTreeMap[int, int](types.IntComparator)
+	model := map[int]int{}
+	for i := 0; i < 10000; i++ {
+		key := r.Intn(2000)
+		if r.Intn(3) == 0 {
+			m = m.Remove(key)
+			delete(model, key)
+		} else {
+			m = m.Put(key, i)
+			model[key] = i
+		}
+	}
+
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	assert.Equal(t, len(model), m.Size())
+	assert.Equal(t, keys, m.Keys())
+	for k, v := range model {
+		val, ok := m.Get(k)
+		assert.True(t, ok)
+		assert.Equal(t, v, val)
+	}
+}
+
+func TestImmutableTreeMap_OrderedReads(t *testing.T) {
+	m := MakeImmutableTreeMap[int, string](types.IntComparator).Put(20, "b").Put(10, "a").Put(30, "c")
+
+	assert.Equal(t, 10, m.First())
+	assert.Equal(t, 30, m.Last())
+	assert.Equal(t, []int{20, 30}, m.RemoveFirst().Keys())
+	assert.Equal(t, []int{10, 20}, m.RemoveLast().Keys())
+
+	entry, ok := m.Floor(25)
+	assert.True(t, ok)
+	assert.Equal(t, 20, entry.Key)
+
+	entry, ok = m.Ceiling(20)
+	assert.True(t, ok)
+	assert.Equal(t, 20, entry.Key)
+
+	entry, ok = m.Higher(20)
+	assert.True(t, ok)
+	assert.Equal(t, 30, entry.Key)
+
+	entry, ok = m.Lower(20)
+	assert.True(t, ok)
+	assert.Equal(t, 10, entry.Key)
+
+	_, ok = m.Higher(30)
+	assert.False(t, ok)
+}
+
+func TestImmutableTreeMap_Range(t *testing.T) {
+	m := MakeImmutableTreeMap[int, int](types.IntComparator)
+	for i := 0; i < 10; i++ {
+		m = m.Put(i, i*i)
+	}
+
+	assert.Equal(t, []dict.Entry[int, int]{{Key: 3, Val: 9}, {Key: 4, Val: 16}}, m.Range(3, 5))
+
+	var visited []int
+	m.ForEachInRange(2, 8, func(key int, _ int) bool {
+		visited = append(visited, key)
+		return key < 4
+	})
+	assert.Equal(t, []int{2, 3, 4}, visited)
+}
+
+func TestImmutableTreeMap_Diff(t *testing.T) {
+	base := MakeImmutableTreeMap[string, int](types.StringComparator)
+	for i, key := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		base = base.Put(key, i)
+	}
+	next := base.Remove("b").Put("c", 20).Put("h", 7)
+
+	assert.Equal(t, []DiffEntry[string, int]{
+		{Kind: Removed, Key: "b", OldVal: 1},
+		{Kind: Changed, Key: "c", OldVal: 2, NewVal: 20},
+		{Kind: Added, Key: "h", NewVal: 7},
+	}, base.Diff(next))
+	assert.Empty(t, base.Diff(base))
+	assert.Len(t, MakeImmutableTreeMap[string, int](types.StringComparator).Diff(base), 7)
+}
+
+func TestImmutableTreeMap_DiffUnrelatedMaps(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	a := MakeImmutableTreeMap[int, int](types.IntComparator)
+	b := MakeImmutableTreeMap[int, int](types.IntComparator)
+	for i := 0; i < 500; i++ {
+		a = a.Put(r.Intn(300), r.Intn(3))
+		b = b.Put(r.Intn(300), r.Intn(3))
+	}
+
+	// applying the diff must turn a into b
+	patched := a
+	for _, d := range a.Diff(b) {
+		if d.Kind == Removed {
+			patched = patched.Remove(d.Key)
+		} else {
+			patched = patched.Put(d.Key, d.NewVal)
+		}
+	}
+	assert.Equal(t, b.Entries(), patched.Entries())
+}
+
+func TestImmutableTreeMap_Formatted(t *testing.T) {
+	m := MakeImmutableTreeMap[int, string](types.IntComparator).Put(2, "two").Put(1, "one")
+
+	assert.Equal(t, "{1: one, 2: two}", m.Formatted())
+}
@@ -0,0 +1,182 @@
+package immutable
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"utils-generics/collections"
+)
+
+// File: validate.go
+// Checking of the internal invariants of the persistent collections, see collections.Validator.
+//
+// The collections are never modified in place, so in debug builds every new version is checked as it is created.
+
+// checked returns the new version of a collection, panicking first if it breaks its invariants in debug builds
+func checked[C collections.Validator](c C) C {
+	if collections.Debug {
+		collections.MustBeValid(c)
+	}
+	return c
+}
+
+// Validate checks that the keys are in strictly increasing order from left to right, that every node holds the size
+// of its subtree and that the tree is weight balanced
+func (m *ImmutableTreeMap[K, V]) Validate() error {
+	_, err := m.validateNode(m.root, nil, nil)
+	return err
+}
+
+// validateNode checks the subtree, whose keys must lie strictly between min and max when they are not nil,
+// and returns its size
+func (m *ImmutableTreeMap[K, V]) validateNode(node *treeNode[K, V], min, max *K) (int, error) {
+	if node == nil {
+		return 0, nil
+	}
+	if (min != nil && m.comparator(node.Key, *min) <= 0) || (max != nil && m.comparator(node.Key, *max) >= 0) {
+		return 0, fmt.Errorf("immutable: ImmutableTreeMap key %v is out of order", node.Key)
+	}
+
+	sl, err := m.validateNode(node.left, min, &node.Key)
+	if err != nil {
+		return 0, err
+	}
+	sr, err := m.validateNode(node.right, &node.Key, max)
+	if err != nil {
+		return 0, err
+	}
+	if node.size != sl+sr+1 {
+		return 0, fmt.Errorf("immutable: ImmutableTreeMap node %v holds size %d instead of %d", node.Key, node.size, sl+sr+1)
+	}
+	if sl+sr > 1 && (sl > treeDelta*sr || sr > treeDelta*sl) {
+		return 0, fmt.Errorf("immutable: ImmutableTreeMap node %v is unbalanced, with %d keys on the left and %d on the right", node.Key, sl, sr)
+	}
+	return node.size, nil
+}
+
+// Validate checks that every entry sits where its hash leads, that the trie is canonical i.e. no sub-node could be
+// inlined in its parent, and that the map holds as many entries as its size
+func (m *ImmutableHashMap[K, V]) Validate() error {
+	if m.root == nil {
+		return nil
+	}
+
+	count := 0
+	if err := m.validateNode(m.root, 0, 0, &count); err != nil {
+		return err
+	}
+	if count != m.size {
+		return fmt.Errorf("immutable: ImmutableHashMap holds %d entries but its size is %d", count, m.size)
+	}
+	return nil
+}
+
+// validateNode checks the node found at the shift by following the given hash prefix, counting its entries
+func (m *ImmutableHashMap[K, V]) validateNode(node *hamtNode[K, V], shift uint, prefix uint32, count *int) error {
+	*count += len(node.entries)
+	prefixMask := uint32(1)<<shift - 1
+	for _, e := range node.entries {
+		if hash := m.hash(e.key); e.hash != hash {
+			return fmt.Errorf("immutable: ImmutableHashMap key %v holds hash %#x instead of %#x", e.key, e.hash, hash)
+		}
+		if shift < hashWidth && e.hash&prefixMask != prefix {
+			return fmt.Errorf("immutable: ImmutableHashMap key %v is not on the path of its hash", e.key)
+		}
+	}
+
+	if shift >= hashWidth {
+		// collision node: every entry shares the full hash
+		for _, e := range node.entries {
+			if e.hash != node.entries[0].hash {
+				return fmt.Errorf("immutable: ImmutableHashMap key %v is in a collision node of another hash", e.key)
+			}
+		}
+		return nil
+	}
+
+	if node.dataMap&node.nodeMap != 0 {
+		return fmt.Errorf("immutable: ImmutableHashMap node at depth %d has a slot holding both an entry and a sub-node", shift/hamtBits)
+	}
+	if bits.OnesCount32(node.dataMap) != len(node.entries) || bits.OnesCount32(node.nodeMap) != len(node.children) {
+		return fmt.Errorf("immutable: ImmutableHashMap node at depth %d does not match its bitmaps", shift/hamtBits)
+	}
+	for _, e := range node.entries {
+		bit := bitPosition(e.hash, shift)
+		if node.dataMap&bit == 0 || node.entries[bitIndex(node.dataMap, bit)].hash != e.hash {
+			return fmt.Errorf("immutable: ImmutableHashMap key %v is not in the slot of its hash", e.key)
+		}
+	}
+
+	i := 0
+	for slot := uint32(0); slot < 1<<hamtBits; slot++ {
+		if node.nodeMap&(1<<slot) == 0 {
+			continue
+		}
+		child := node.children[i]
+		i++
+		if len(child.children) == 0 && len(child.entries) < 2 {
+			return fmt.Errorf("immutable: ImmutableHashMap sub-node at depth %d should be inlined in its parent", shift/hamtBits+1)
+		}
+		if err := m.validateNode(child, shift+hamtBits, prefix|slot<<shift, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the invariants of the underlying map
+func (s *ImmutableHashSet[K]) Validate() error {
+	return s.innerMap.Validate()
+}
+
+// Validate checks that the list holds as many values as its size
+func (l *ImmutableList[T]) Validate() error {
+	count := 0
+	for cell := l.head; cell != nil; cell = cell.next {
+		count++
+	}
+	if count != l.size {
+		return fmt.Errorf("immutable: ImmutableList holds %d values but its size is %d", count, l.size)
+	}
+	return nil
+}
+
+// Validate checks that the tail holds the values past the last full leaf, and that the trie holds the other ones
+// in full leaves that are all as deep as the shift of the vector
+func (v *Vector[T]) Validate() error {
+	if v.root == nil {
+		return errors.New("immutable: Vector has no root")
+	}
+	if len(v.tail) != v.size-v.tailOffset() || (v.size > 0 && len(v.tail) == 0) {
+		return fmt.Errorf("immutable: Vector tail holds %d values for a size of %d", len(v.tail), v.size)
+	}
+
+	count := 0
+	if err := validateVectorNode(v.root, v.shift, &count); err != nil {
+		return err
+	}
+	if count != v.tailOffset() {
+		return fmt.Errorf("immutable: Vector trie holds %d values instead of %d", count, v.tailOffset())
+	}
+	return nil
+}
+
+func validateVectorNode[T any](node *vectorNode[T], level uint, count *int) error {
+	if level == 0 {
+		if len(node.values) != vectorWidth || len(node.children) != 0 {
+			return fmt.Errorf("immutable: Vector leaf holds %d values and %d children", len(node.values), len(node.children))
+		}
+		*count += len(node.values)
+		return nil
+	}
+
+	if len(node.values) != 0 || len(node.children) > vectorWidth {
+		return fmt.Errorf("immutable: Vector internal node holds %d values and %d children", len(node.values), len(node.children))
+	}
+	for _, child := range node.children {
+		if err := validateVectorNode(child, level-vectorBits, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
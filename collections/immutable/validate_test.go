@@ -0,0 +1,68 @@
+package immutable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
+)
+
+func TestImmutableTreeMap_Validate(t *testing.T) {
+	m := MakeImmutableTreeMap[int, int](types.IntComparator)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		if r.Intn(3) == 0 {
+			m = m.Remove(r.Intn(500))
+		} else {
+			m = m.Put(r.Intn(500), i)
+		}
+	}
+	assert.NoError(t, m.Validate())
+
+	m.root.size++
+	assert.ErrorContains(t, m.Validate(), "holds size")
+
+	unbalanced := &ImmutableTreeMap[int, int]{comparator: types.IntComparator}
+	for i := 0; i < 5; i++ {
+		unbalanced.root = newTreeNode(dict.Entry[int, int]{Key: i}, unbalanced.root, nil)
+	}
+	assert.ErrorContains(t, unbalanced.Validate(), "is unbalanced")
+}
+
+func TestImmutableHashMap_Validate(t *testing.T) {
+	// a narrow hash makes sure the trie gets deep and collision nodes show up
+	m := MakeImmutableHashMap[int, int](func(key int) int { return key % 3000 })
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		if r.Intn(3) == 0 {
+			m = m.Remove(r.Intn(10000))
+		} else {
+			m = m.Put(r.Intn(10000), i)
+		}
+	}
+	assert.NoError(t, m.Validate())
+
+	broken := &ImmutableHashMap[int, int]{root: m.root, size: m.size + 1, hasher: m.hasher, equals: m.equals}
+	assert.ErrorContains(t, broken.Validate(), "but its size is")
+}
+
+func TestVector_Validate(t *testing.T) {
+	v := MakeVector[int]()
+	for i := 0; i < 2000; i++ {
+		v = v.Append(i)
+	}
+	v = v.Set(5, 50).Set(1999, 0).Slice(3, 1500)
+	assert.NoError(t, v.Validate())
+
+	v.tail = v.tail[1:]
+	assert.ErrorContains(t, v.Validate(), "tail holds")
+}
+
+func TestImmutableList_Validate(t *testing.T) {
+	l := MakeImmutableList(1, 2, 3).Append(4).Tail()
+	assert.NoError(t, l.Validate())
+
+	l.size = 4
+	assert.EqualError(t, l.Validate(), "immutable: ImmutableList holds 3 values but its size is 4")
+}
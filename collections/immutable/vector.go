@@ -0,0 +1,248 @@
+package immutable
+
+import "utils-generics/collections/list"
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// vectorNode is either an internal node, holding children, or a leaf, holding up to vectorWidth values
+type vectorNode[T any] struct {
+	children []*vectorNode[T]
+	values   []T
+}
+
+// Vector is a persistent indexed sequence implemented as a 32-way trie, with the last values kept in a separate tail
+// so appending rarely touches the trie.
+//
+// It is never modified in place: Append and Set copy the path from the root to the changed leaf and return a new
+// version of the vector, sharing every other node with the previous one.
+//
+// It's performance characteristics are:
+//
+// - Append: O(log32 n)
+//
+// - Get: O(log32 n)
+//
+// - Set: O(log32 n)
+//
+// - Slice: O(m log32 m) for a slice of m values
+//
+// - Concat: O(m log32 (n + m)) for another vector of m values
+type Vector[T any] struct {
+	root  *vectorNode[T]
+	tail  []T
+	size  int
+	shift uint
+}
+
+// MakeVector creates a new Vector holding the values in the given order
+func MakeVector[T any](values ...T) *Vector[T] {
+	v := &Vector[T]{root: &vectorNode[T]{}, shift: vectorBits}
+	for _, val := range values {
+		v = v.Append(val)
+	}
+	return v
+}
+
+// MakeVectorFrom creates a new Vector holding the values of a mutable list, e.g. a LinkedList
+func MakeVectorFrom[T any](l list.List[T]) *Vector[T] {
+	return MakeVector(listValues(l)...)
+}
+
+// tailOffset is the index of the first value kept in the tail
+func (v *Vector[T]) tailOffset() int {
+	if v.size < vectorWidth {
+		return 0
+	}
+	return ((v.size - 1) >> vectorBits) << vectorBits
+}
+
+// Get returns the value at the given index and true if the index is valid, otherwise the zero value and false
+func (v *Vector[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= v.size {
+		var zero T
+		return zero, false
+	}
+	return v.leafFor(index)[index&vectorMask], true
+}
+
+func (v *Vector[T]) leafFor(index int) []T {
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+
+	node := v.root
+	for level := v.shift; level > 0; level -= vectorBits {
+		node = node.children[(index>>level)&vectorMask]
+	}
+	return node.values
+}
+
+// Append returns a new version of the vector with the value added to the end
+func (v *Vector[T]) Append(val T) *Vector[T] {
+	if v.size-v.tailOffset() < vectorWidth {
+		tail := make([]T, len(v.tail), len(v.tail)+1)
+		copy(tail, v.tail)
+		return checked(&Vector[T]{root: v.root, tail: append(tail, val), size: v.size + 1, shift: v.shift})
+	}
+
+	// the tail is full so it is pushed into the trie, growing the trie by one level if the root is full as well
+	leaf := &vectorNode[T]{values: v.tail}
+	root, shift := v.root, v.shift
+	if (v.size >> vectorBits) > (1 << v.shift) {
+		root = &vectorNode[T]{children: []*vectorNode[T]{v.root, newVectorPath(v.shift, leaf)}}
+		shift += vectorBits
+	} else {
+		root = v.pushTail(v.shift, v.root, leaf)
+	}
+	return checked(&Vector[T]{root: root, tail: []T{val}, size: v.size + 1, shift: shift})
+}
+
+func (v *Vector[T]) pushTail(level uint, parent *vectorNode[T], leaf *vectorNode[T]) *vectorNode[T] {
+	index := ((v.size - 1) >> level) & vectorMask
+	node := &vectorNode[T]{children: append([]*vectorNode[T](nil), parent.children...)}
+
+	var child *vectorNode[T]
+	if level == vectorBits {
+		child = leaf
+	} else if index < len(parent.children) {
+		child = v.pushTail(level-vectorBits, parent.children[index], leaf)
+	} else {
+		child = newVectorPath(level-vectorBits, leaf)
+	}
+
+	if index < len(node.children) {
+		node.children[index] = child
+	} else {
+		node.children = append(node.children, child)
+	}
+	return node
+}
+
+func newVectorPath[T any](level uint, leaf *vectorNode[T]) *vectorNode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &vectorNode[T]{children: []*vectorNode[T]{newVectorPath(level-vectorBits, leaf)}}
+}
+
+// Set returns a new version of the vector with the value at the given index replaced.
+// The vector itself is returned if the index is out of bounds.
+func (v *Vector[T]) Set(index int, val T) *Vector[T] {
+	if index < 0 || index >= v.size {
+		return v
+	}
+
+	if index >= v.tailOffset() {
+		tail := append([]T(nil), v.tail...)
+		tail[index&vectorMask] = val
+		return checked(&Vector[T]{root: v.root, tail: tail, size: v.size, shift: v.shift})
+	}
+	return checked(&Vector[T]{root: setInVector(v.shift, v.root, index, val), tail: v.tail, size: v.size, shift: v.shift})
+}
+
+func setInVector[T any](level uint, node *vectorNode[T], index int, val T) *vectorNode[T] {
+	if level == 0 {
+		values := append([]T(nil), node.values...)
+		values[index&vectorMask] = val
+		return &vectorNode[T]{values: values}
+	}
+
+	children := append([]*vectorNode[T](nil), node.children...)
+	i := (index >> level) & vectorMask
+	children[i] = setInVector(level-vectorBits, children[i], index, val)
+	return &vectorNode[T]{children: children}
+}
+
+// Slice returns a new vector with the values between from (inclusive) and to (exclusive),
+// clamped to the bounds of the vector
+func (v *Vector[T]) Slice(from, to int) *Vector[T] {
+	if from < 0 {
+		from = 0
+	}
+	if to > v.size {
+		to = v.size
+	}
+
+	sliced := MakeVector[T]()
+	for i := from; i < to; i++ {
+		sliced = sliced.Append(v.leafFor(i)[i&vectorMask])
+	}
+	return sliced
+}
+
+// Concat returns a new vector with the values of this vector followed by the values of the other one.
+// This vector is shared, the values of the other one are appended to it.
+func (v *Vector[T]) Concat(other *Vector[T]) *Vector[T] {
+	concat := v
+	other.ForEach(func(_ int, val T) bool {
+		concat = concat.Append(val)
+		return true
+	})
+	return concat
+}
+
+// ForEach visits every value of the vector in order, stopping as soon as visit returns false
+func (v *Vector[T]) ForEach(visit func(index int, val T) bool) {
+	for i := 0; i < v.size; i += vectorWidth {
+		leaf := v.leafFor(i)
+		for j, val := range leaf {
+			if !visit(i+j, val) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of values in the vector
+func (v *Vector[T]) Size() int {
+	return v.size
+}
+
+// IsEmpty returns true if the vector is empty
+func (v *Vector[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+// IsNotEmpty returns true if the vector is not empty
+func (v *Vector[T]) IsNotEmpty() bool {
+	return v.size != 0
+}
+
+// Formatted returns a string representation of the vector
+func (v *Vector[T]) Formatted() string {
+	return v.String()
+}
+
+// ToSlice returns a slice with the values of the vector, in order
+func (v *Vector[T]) ToSlice() []T {
+	values := make([]T, 0, v.size)
+	v.ForEach(func(_ int, val T) bool {
+		values = append(values, val)
+		return true
+	})
+	return values
+}
+
+// ToLinkedList returns a mutable LinkedList with the values of the vector
+func (v *Vector[T]) ToLinkedList() *list.LinkedList[T] {
+	linked := list.MakeLinkedList[T]()
+	v.ForEach(func(_ int, val T) bool {
+		linked.Add(val)
+		return true
+	})
+	return linked
+}
+
+// ToDoubleLinkedList returns a mutable DoubleLinkedList with the values of the vector
+func (v *Vector[T]) ToDoubleLinkedList() *list.DoubleLinkedList[T] {
+	linked := list.MakeDoubleLinkedList[T]()
+	v.ForEach(func(_ int, val T) bool {
+		linked.Add(val)
+		return true
+	})
+	return linked
+}
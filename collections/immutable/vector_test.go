@@ -0,0 +1,82 @@
+package immutable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/list"
+)
+
+func TestVector_Append(t *testing.T) {
+	v := MakeVector[int]()
+	versions := []*Vector[int]{v}
+	for i := 0; i < 5000; i++ {
+		v = v.Append(i)
+		versions = append(versions, v)
+	}
+
+	assert.Equal(t, 5000, v.Size())
+	for i := 0; i < 5000; i++ {
+		val, ok := v.Get(i)
+		assert.True(t, ok)
+		assert.Equal(t, i, val)
+	}
+
+	// older versions are untouched
+	for _, n := range []int{0, 1, 32, 33, 1024, 1057, 4999} {
+		assert.Equal(t, n, versions[n].Size())
+		_, ok := versions[n].Get(n)
+		assert.False(t, ok)
+	}
+}
+
+func TestVector_Set(t *testing.T) {
+	v := MakeVector[int]()
+	for i := 0; i < 2000; i++ {
+		v = v.Append(i)
+	}
+
+	set := v.Set(10, -10).Set(1999, -1999)
+
+	val, _ := set.Get(10)
+	assert.Equal(t, -10, val)
+	val, _ = set.Get(1999)
+	assert.Equal(t, -1999, val)
+	val, _ = v.Get(10)
+	assert.Equal(t, 10, val)
+	assert.Same(t, v, v.Set(2000, 0))
+}
+
+func TestVector_Slice(t *testing.T) {
+	v := MakeVector[int]()
+	for i := 0; i < 100; i++ {
+		v = v.Append(i)
+	}
+
+	assert.Equal(t, []int{30, 31, 32, 33}, v.Slice(30, 34).ToSlice())
+	assert.Equal(t, 100, v.Slice(-5, 500).Size())
+	assert.True(t, v.Slice(10, 5).IsEmpty())
+}
+
+func TestVector_Concat(t *testing.T) {
+	a := MakeVector(1, 2)
+	b := MakeVector(3, 4)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, a.Concat(b).ToSlice())
+	assert.Equal(t, []int{1, 2}, a.ToSlice())
+}
+
+func TestVector_Formatted(t *testing.T) {
+	assert.Equal(t, "[1, 2, 3]", MakeVector(1, 2, 3).Formatted())
+	assert.Equal(t, "[]", MakeVector[int]().Formatted())
+}
+
+func TestVector_LinkedListConversions(t *testing.T) {
+	linked := list.MakeDoubleLinkedList[string]()
+	linked.Add("a")
+	linked.Add("b")
+
+	v := MakeVectorFrom[string](linked)
+	assert.Equal(t, []string{"a", "b"}, v.ToSlice())
+	assert.Equal(t, []string{"a", "b"}, v.ToLinkedList().ToSlice())
+	assert.Equal(t, []string{"a", "b"}, v.ToDoubleLinkedList().ToSlice())
+}
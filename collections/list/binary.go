@@ -0,0 +1,124 @@
+package list
+
+import (
+	"utils-generics/collections/codec"
+	"utils-generics/collections/types"
+)
+
+// File: binary.go
+// Binary encoding of the lists, queues and stacks, in the Values layout of the codec package.
+// Like for JSON, queues are written in fifo order and stacks from the bottom to the top.
+
+// MarshalBinary encodes the list with the default codec of its values
+func (l *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	return l.EncodeBinary(codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the values of the list with the ones decoded with the default codec
+func (l *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	return l.DecodeBinary(data, codec.Default[T]())
+}
+
+// EncodeBinary encodes the list with the given codec
+func (l *LinkedList[T]) EncodeBinary(c codec.Codec[T]) ([]byte, error) {
+	return codec.EncodeValues(l.ToSlice(), c)
+}
+
+// DecodeBinary replaces the values of the list with the ones decoded with the given codec
+func (l *LinkedList[T]) DecodeBinary(data []byte, c codec.Codec[T]) error {
+	values, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+
+	if l.equals == nil {
+		l.equals = types.DeepEquals[T]
+	}
+	l.Clear()
+	for _, val := range values {
+		l.Add(val)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the list with the default codec of its values
+func (l *DoubleLinkedList[T]) MarshalBinary() ([]byte, error) {
+	return l.EncodeBinary(codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the values of the list with the ones decoded with the default codec
+func (l *DoubleLinkedList[T]) UnmarshalBinary(data []byte) error {
+	return l.DecodeBinary(data, codec.Default[T]())
+}
+
+// EncodeBinary encodes the list with the given codec
+func (l *DoubleLinkedList[T]) EncodeBinary(c codec.Codec[T]) ([]byte, error) {
+	return codec.EncodeValues(l.ToSlice(), c)
+}
+
+// DecodeBinary replaces the values of the list with the ones decoded with the given codec
+func (l *DoubleLinkedList[T]) DecodeBinary(data []byte, c codec.Codec[T]) error {
+	values, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+
+	if l.equals == nil {
+		l.equals = types.DeepEquals[T]
+	}
+	l.Clear()
+	for _, val := range values {
+		l.Add(val)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the queue in fifo order with the default codec of its values
+func (q *SimpleQueue[T]) MarshalBinary() ([]byte, error) {
+	return q.EncodeBinary(codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the values of the queue with the ones decoded with the default codec
+func (q *SimpleQueue[T]) UnmarshalBinary(data []byte) error {
+	return q.DecodeBinary(data, codec.Default[T]())
+}
+
+// EncodeBinary encodes the queue in fifo order with the given codec
+func (q *SimpleQueue[T]) EncodeBinary(c codec.Codec[T]) ([]byte, error) {
+	return codec.EncodeValues(q.elements, c)
+}
+
+// DecodeBinary replaces the values of the queue with the ones decoded with the given codec
+func (q *SimpleQueue[T]) DecodeBinary(data []byte, c codec.Codec[T]) error {
+	values, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+	q.elements = values
+	return nil
+}
+
+// MarshalBinary encodes the stack from the bottom to the top with the default codec of its values
+func (s *SimpleStack[T]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[T]())
+}
+
+// UnmarshalBinary replaces the values of the stack with the ones decoded with the default codec
+func (s *SimpleStack[T]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[T]())
+}
+
+// EncodeBinary encodes the stack from the bottom to the top with the given codec
+func (s *SimpleStack[T]) EncodeBinary(c codec.Codec[T]) ([]byte, error) {
+	return codec.EncodeValues(s.elements, c)
+}
+
+// DecodeBinary replaces the values of the stack with the ones decoded with the given codec
+func (s *SimpleStack[T]) DecodeBinary(data []byte, c codec.Codec[T]) error {
+	values, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+	s.elements = values
+	return nil
+}
@@ -0,0 +1,94 @@
+package list
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/codec"
+)
+
+type coordinates struct {
+	Lat, Lng float64
+}
+
+func TestLinkedList_Binary(t *testing.T) {
+	l := MakeLinkedList[string]()
+	l.Add("a")
+	l.Add("b")
+
+	data, err := l.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded LinkedList[string]
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []string{"a", "b"}, decoded.ToSlice())
+	assert.True(t, decoded.Contains("a"))
+}
+
+func TestDoubleLinkedList_Binary_GobFallback(t *testing.T) {
+	l := MakeDoubleLinkedList[coordinates]()
+	l.Add(coordinates{1.5, -3})
+
+	data, err := l.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeDoubleLinkedList[coordinates]()
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, l.ToSlice(), decoded.ToSlice())
+}
+
+func TestDoubleLinkedList_Binary_CustomCodec(t *testing.T) {
+	c := codec.MakeCodec(func(buf []byte, val coordinates) ([]byte, error) {
+		buf, _ = codec.Float64[float64]().Append(buf, val.Lat)
+		return codec.Float64[float64]().Append(buf, val.Lng)
+	}, func(data []byte) (coordinates, int, error) {
+		lat, _, err := codec.Float64[float64]().Decode(data)
+		if err != nil {
+			return coordinates{}, 0, err
+		}
+		lng, _, err := codec.Float64[float64]().Decode(data[8:])
+		return coordinates{lat, lng}, 16, err
+	})
+
+	l := MakeDoubleLinkedList[coordinates]()
+	l.Add(coordinates{1, 2})
+	l.Add(coordinates{3, 4})
+
+	data, err := l.EncodeBinary(c)
+	assert.NoError(t, err)
+	assert.Len(t, data, 3+2*16)
+
+	decoded := MakeDoubleLinkedList[coordinates]()
+	assert.NoError(t, decoded.DecodeBinary(data, c))
+	assert.Equal(t, l.ToSlice(), decoded.ToSlice())
+}
+
+func TestSimpleQueue_Gob(t *testing.T) {
+	q := MakeSimpleQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(q))
+
+	var decoded SimpleQueue[int]
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	head, _ := decoded.Peek()
+	assert.Equal(t, 1, head)
+	assert.Equal(t, 2, decoded.Size())
+}
+
+func TestSimpleStack_Binary(t *testing.T) {
+	s := MakeSimpleStack[uint16]()
+	s.Push(1)
+	s.Push(500)
+
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeSimpleStack[uint16]()
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	top, _ := decoded.Pop()
+	assert.Equal(t, uint16(500), top)
+}
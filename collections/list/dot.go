@@ -0,0 +1,46 @@
+package list
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: dot.go
+// Graphviz DOT export of the linked lists, see collections.DOTGraph.
+
+// ToDOT returns the entries of the list as a Graphviz DOT digraph, from the head to the tail
+func (l *LinkedList[T]) ToDOT() string {
+	g := collections.MakeDOTGraph("LinkedList", "rankdir=LR", "node [shape=box]")
+	var prev string
+	for current := l.head; current != nil; current = current.next {
+		id := g.Node(fmt.Sprint(current.val))
+		if prev != "" {
+			g.Edge(prev, id, "label=next")
+		}
+		prev = id
+	}
+	return g.String()
+}
+
+// ToDOT returns the entries of the list as a Graphviz DOT digraph, from the head to the tail,
+// with the prev links drawn dashed
+func (l *DoubleLinkedList[T]) ToDOT() string {
+	g := collections.MakeDOTGraph("DoubleLinkedList", "rankdir=LR", "node [shape=box]")
+	ids := map[*biDirectionalEntry[T]]string{}
+	var prev string
+	for current := l.head; current != nil; current = current.next {
+		id := g.Node(fmt.Sprint(current.val))
+		if prev != "" {
+			g.Edge(prev, id, "label=next")
+		}
+		ids[current] = id
+		prev = id
+	}
+	// the prev links are drawn as they are, so a link that does not mirror a next link stands out
+	for current := l.head; current != nil; current = current.next {
+		if to, ok := ids[current.prev]; ok {
+			g.Edge(ids[current], to, "label=prev", "style=dashed")
+		}
+	}
+	return g.String()
+}
@@ -0,0 +1,47 @@
+package list
+
+import (
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func assertGolden(t *testing.T, name string, actual string) {
+	golden := filepath.Join("testdata", name+".dot.golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(actual), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(expected), actual)
+}
+
+func TestLinkedList_ToDOT(t *testing.T) {
+	l := MakeLinkedList[string]()
+	l.Add("a")
+	l.Add(`say "hi"`)
+	l.Add("c")
+
+	assertGolden(t, "linked_list", l.ToDOT())
+}
+
+func TestDoubleLinkedList_ToDOT(t *testing.T) {
+	l := MakeDoubleLinkedList[int]()
+	for i := 1; i <= 3; i++ {
+		l.Add(i)
+	}
+
+	assertGolden(t, "double_linked_list", l.ToDOT())
+}
@@ -1,8 +1,8 @@
 package list
 
 import (
-	"fmt"
-	"reflect"
+	"utils-generics/collections"
+	"utils-generics/collections/types"
 )
 
 type biDirectionalEntry[T any] struct {
@@ -12,16 +12,32 @@ type biDirectionalEntry[T any] struct {
 }
 
 type DoubleLinkedList[T any] struct {
-	head *biDirectionalEntry[T]
-	tail *biDirectionalEntry[T]
+	head   *biDirectionalEntry[T]
+	tail   *biDirectionalEntry[T]
+	equals func(a, b T) bool
 }
 
+// MakeDoubleLinkedList returns a pointer to a new DoubleLinkedList that compares values with reflect.DeepEqual
 func MakeDoubleLinkedList[T any]() *DoubleLinkedList[T] {
-	return &DoubleLinkedList[T]{nil, nil}
+	return MakeDoubleLinkedListWithEquals[T](types.DeepEquals[T])
+}
+
+// MakeDoubleLinkedListWithEquals returns a pointer to a new DoubleLinkedList that compares values with the provided function
+func MakeDoubleLinkedListWithEquals[T any](equals func(a, b T) bool) *DoubleLinkedList[T] {
+	return &DoubleLinkedList[T]{nil, nil, equals}
+}
+
+// MakeComparableDoubleLinkedList returns a pointer to a new DoubleLinkedList of comparable values, compared with ==
+func MakeComparableDoubleLinkedList[T comparable]() *DoubleLinkedList[T] {
+	return MakeDoubleLinkedListWithEquals[T](types.Equals[T])
 }
 
 // Add adds a new entry to the end of the list
 func (l *DoubleLinkedList[T]) Add(val T) {
+	if collections.Debug {
+		defer collections.MustBeValid(l)
+	}
+
 	newEntry := &biDirectionalEntry[T]{val, nil, nil}
 	if l.head == nil {
 		l.head = newEntry
@@ -43,7 +59,7 @@ func (l *DoubleLinkedList[T]) Add(val T) {
 }
 
 func (l *DoubleLinkedList[T]) Get(index int) (T, bool) {
-	if l.head == nil {
+	if l.head == nil || index < 0 {
 		var zero T
 		return zero, false
 	}
@@ -61,11 +77,15 @@ func (l *DoubleLinkedList[T]) Get(index int) (T, bool) {
 }
 
 func (l *DoubleLinkedList[T]) Remove(val T) bool {
+	if collections.Debug {
+		defer collections.MustBeValid(l)
+	}
+
 	if l.head == nil {
 		return false
 	}
 
-	if reflect.DeepEqual(l.head.val, val) {
+	if l.equals(l.head.val, val) {
 		l.head = l.head.next
 		if l.head != nil {
 			l.head.prev = nil
@@ -77,7 +97,7 @@ func (l *DoubleLinkedList[T]) Remove(val T) bool {
 
 	current := l.head.next
 	for current != nil {
-		if reflect.DeepEqual(current.val, val) {
+		if l.equals(current.val, val) {
 			current.prev.next = current.next
 			if current.next != nil {
 				current.next.prev = current.prev
@@ -100,7 +120,7 @@ func (l *DoubleLinkedList[T]) Contains(val T) bool {
 
 	current := l.head
 	for current != nil {
-		if reflect.DeepEqual(current.val, val) {
+		if l.equals(current.val, val) {
 			return true
 		}
 		current = current.next
@@ -109,6 +129,15 @@ func (l *DoubleLinkedList[T]) Contains(val T) bool {
 	return false
 }
 
+// ToSlice returns a slice with the values of the list, in order
+func (l *DoubleLinkedList[T]) ToSlice() []T {
+	var values []T
+	for current := l.head; current != nil; current = current.next {
+		values = append(values, current.val)
+	}
+	return values
+}
+
 // Size returns the number of entries in the list
 func (l *DoubleLinkedList[T]) Size() int {
 	if l.head == nil {
@@ -143,20 +172,5 @@ func (l *DoubleLinkedList[T]) IsNotEmpty() bool {
 
 // Formatted returns a string representation of the list
 func (l *DoubleLinkedList[T]) Formatted() string {
-	if l.head == nil {
-		return "[]"
-	}
-
-	current := l.head
-	formatted := "["
-	for current != nil {
-		formatted += fmt.Sprintf("%v", current.val)
-		if current.next != nil {
-			formatted += ", "
-		}
-		current = current.next
-	}
-	formatted += "]"
-
-	return formatted
+	return l.String()
 }
@@ -0,0 +1,85 @@
+package list
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: format.go
+// Printing of the lists, queues and stacks through fmt, see collections.Format for the supported verbs and flags.
+
+// String returns the values of the list, as printed by the %v verb
+func (l *LinkedList[T]) String() string {
+	return fmt.Sprint(l)
+}
+
+// Format implements fmt.Formatter
+func (l *LinkedList[T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, l, collections.Contents{Size: l.Size(), Open: "[", Close: "]",
+		ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			for current := l.head; current != nil; current = current.next {
+				if !visit(nil, current.val) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the values of the list, as printed by the %v verb
+func (l *DoubleLinkedList[T]) String() string {
+	return fmt.Sprint(l)
+}
+
+// Format implements fmt.Formatter
+func (l *DoubleLinkedList[T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, l, collections.Contents{Size: l.Size(), Open: "[", Close: "]",
+		ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			for current := l.head; current != nil; current = current.next {
+				if !visit(nil, current.val) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the values of the queue in fifo order, as printed by the %v verb
+func (q *SimpleQueue[T]) String() string {
+	return fmt.Sprint(q)
+}
+
+// Format implements fmt.Formatter, printing the values in fifo order
+func (q *SimpleQueue[T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, q, collections.Contents{Size: len(q.elements), Open: "[", Close: "]",
+		ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			for _, val := range q.elements {
+				if !visit(nil, val) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the values of the stack from the top to the bottom, as printed by the %v verb
+func (s *SimpleStack[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the values from the top to the bottom
+func (s *SimpleStack[T]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, collections.Contents{Size: len(s.elements), Open: "[", Close: "]",
+		ValType: collections.TypeOf[T](),
+		Each: func(visit func(key, val any) bool) {
+			for i := len(s.elements) - 1; i >= 0; i-- {
+				if !visit(nil, s.elements[i]) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,45 @@
+package list
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLinkedList_Format(t *testing.T) {
+	l := MakeLinkedList[string]()
+	l.Add("a")
+	l.Add("b")
+
+	assert.Equal(t, "[a, b]", l.Formatted())
+	assert.Equal(t, `[]string{"a", "b"}`, fmt.Sprintf("%#v", l))
+	assert.Equal(t, "[]", MakeLinkedList[string]().String())
+}
+
+func TestDoubleLinkedList_Format(t *testing.T) {
+	l := MakeDoubleLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		l.Add(i)
+	}
+
+	assert.Equal(t, "[1, 2, 3, 4, 5]", l.Formatted())
+	assert.Equal(t, "list.DoubleLinkedList[int] size=5 [1, 2, 3, ... 2 more]", fmt.Sprintf("%+.3v", l))
+}
+
+func TestSimpleQueue_Format(t *testing.T) {
+	q := MakeSimpleQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+
+	assert.Equal(t, "[1, 2]", q.Formatted())
+}
+
+func TestSimpleStack_Format(t *testing.T) {
+	s := MakeSimpleStack[int]()
+	s.Push(1)
+	s.Push(2)
+
+	// the top of the stack is printed first
+	assert.Equal(t, "[2, 1]", s.Formatted())
+	assert.Equal(t, "[  2,   1]", fmt.Sprintf("%3d", s))
+}
@@ -0,0 +1,130 @@
+package list
+
+import (
+	"bytes"
+	"testing"
+	"utils-generics/collections"
+)
+
+// fuzzList decodes the fuzz input two bytes at a time, an operation and a value, and runs the operations against
+// the list and a builtin slice, failing as soon as they disagree or the list breaks one of its invariants.
+// The operation byte 0xff clears the list.
+func fuzzList(t *testing.T, l List[byte], data []byte) {
+	var model []byte
+	for i := 0; i+1 < len(data); i += 2 {
+		code, val := data[i], data[i+1]
+		switch {
+		case code == 0xff:
+			l.Clear()
+			model = nil
+		case code%4 < 2:
+			l.Add(val)
+			model = append(model, val)
+		case code%4 == 2:
+			index := bytes.IndexByte(model, val)
+			if removed := l.Remove(val); removed != (index >= 0) {
+				t.Fatalf("operation %d: Remove(%d) = %t, expected %t", i/2, val, removed, index >= 0)
+			}
+			if index >= 0 {
+				model = append(model[:index], model[index+1:]...)
+			}
+		default:
+			// the value doubles as an index, reaching past the end of the list as well
+			index := int(int8(val))
+			got, ok := l.Get(index)
+			if ok != (index >= 0 && index < len(model)) || (ok && got != model[index]) {
+				t.Fatalf("operation %d: Get(%d) = %d, %t for %v", i/2, index, got, ok, model)
+			}
+			if l.Contains(val) != (bytes.IndexByte(model, val) >= 0) {
+				t.Fatalf("operation %d: Contains(%d) disagrees with %v", i/2, val, model)
+			}
+		}
+
+		if l.Size() != len(model) {
+			t.Fatalf("operation %d: Size() = %d, expected %d", i/2, l.Size(), len(model))
+		}
+		if err := l.(collections.Validator).Validate(); err != nil {
+			t.Fatalf("operation %d: %v", i/2, err)
+		}
+	}
+
+	if slice := l.(interface{ ToSlice() []byte }).ToSlice(); !bytes.Equal(slice, model) {
+		t.Fatalf("ToSlice() = %v, expected %v", slice, model)
+	}
+}
+
+func FuzzLinkedList(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzList(t, MakeComparableLinkedList[byte](), data)
+	})
+}
+
+func FuzzDoubleLinkedList(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzList(t, MakeComparableDoubleLinkedList[byte](), data)
+	})
+}
+
+// FuzzSimpleQueue and FuzzSimpleStack read one byte per operation: values below 0x80 are pushed,
+// the others pop a value or peek at the next one
+func FuzzSimpleQueue(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		q := MakeSimpleQueue[byte]()
+		var model []byte
+		for i, b := range data {
+			if b < 0x80 {
+				q.Enqueue(b)
+				model = append(model, b)
+				continue
+			}
+
+			var val byte
+			var ok bool
+			if b%2 == 0 {
+				val, ok = q.Dequeue()
+			} else {
+				val, ok = q.Peek()
+			}
+			if ok != (len(model) > 0) || (ok && val != model[0]) {
+				t.Fatalf("operation %d: got %d, %t for %v", i, val, ok, model)
+			}
+			if ok && b%2 == 0 {
+				model = model[1:]
+			}
+			if q.Size() != len(model) {
+				t.Fatalf("operation %d: Size() = %d, expected %d", i, q.Size(), len(model))
+			}
+		}
+	})
+}
+
+func FuzzSimpleStack(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		s := MakeSimpleStack[byte]()
+		var model []byte
+		for i, b := range data {
+			if b < 0x80 {
+				s.Push(b)
+				model = append(model, b)
+				continue
+			}
+
+			var val byte
+			var ok bool
+			if b%2 == 0 {
+				val, ok = s.Pop()
+			} else {
+				val, ok = s.Peek()
+			}
+			if ok != (len(model) > 0) || (ok && val != model[len(model)-1]) {
+				t.Fatalf("operation %d: got %d, %t for %v", i, val, ok, model)
+			}
+			if ok && b%2 == 0 {
+				model = model[:len(model)-1]
+			}
+			if s.Size() != len(model) {
+				t.Fatalf("operation %d: Size() = %d, expected %d", i, s.Size(), len(model))
+			}
+		}
+	})
+}
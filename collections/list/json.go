@@ -0,0 +1,103 @@
+package list
+
+import (
+	"encoding/json"
+	"utils-generics/collections/types"
+)
+
+// File: json.go
+// JSON encoding of the lists, queues and stacks, which all encode as JSON arrays.
+//
+// Unmarshalling replaces their contents. Lists decoded into their zero value compare values with reflect.DeepEqual,
+// like the ones created with MakeLinkedList and MakeDoubleLinkedList.
+
+// marshalValues encodes the values as a JSON array, never as null
+func marshalValues[T any](values []T) ([]byte, error) {
+	if values == nil {
+		values = []T{}
+	}
+	return json.Marshal(values)
+}
+
+func unmarshalValues[T any](data []byte) ([]T, error) {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// MarshalJSON encodes the list as a JSON array
+func (l *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return marshalValues(l.ToSlice())
+}
+
+// UnmarshalJSON replaces the values of the list with the ones of a JSON array
+func (l *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalValues[T](data)
+	if err != nil {
+		return err
+	}
+
+	if l.equals == nil {
+		l.equals = types.DeepEquals[T]
+	}
+	l.Clear()
+	for _, val := range values {
+		l.Add(val)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the list as a JSON array
+func (l *DoubleLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return marshalValues(l.ToSlice())
+}
+
+// UnmarshalJSON replaces the values of the list with the ones of a JSON array
+func (l *DoubleLinkedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalValues[T](data)
+	if err != nil {
+		return err
+	}
+
+	if l.equals == nil {
+		l.equals = types.DeepEquals[T]
+	}
+	l.Clear()
+	for _, val := range values {
+		l.Add(val)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the queue as a JSON array, in fifo order
+func (q *SimpleQueue[T]) MarshalJSON() ([]byte, error) {
+	return marshalValues(q.elements)
+}
+
+// UnmarshalJSON replaces the values of the queue with the ones of a JSON array, the first one being the head
+func (q *SimpleQueue[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalValues[T](data)
+	if err != nil {
+		return err
+	}
+	q.elements = append(make([]T, 0, len(values)), values...)
+	return nil
+}
+
+// MarshalJSON encodes the stack as a JSON array, from the bottom to the top of the stack,
+// so that pushing the values in order rebuilds it
+func (s *SimpleStack[T]) MarshalJSON() ([]byte, error) {
+	return marshalValues(s.elements)
+}
+
+// UnmarshalJSON replaces the values of the stack with the ones of a JSON array, the last one being the top
+func (s *SimpleStack[T]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalValues[T](data)
+	if err != nil {
+		return err
+	}
+	s.elements = append(make([]T, 0, len(values)), values...)
+	return nil
+}
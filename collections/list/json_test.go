@@ -0,0 +1,82 @@
+package list
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLinkedList_JSON(t *testing.T) {
+	l := MakeLinkedList[int]()
+	l.Add(1)
+	l.Add(2)
+
+	data, err := json.Marshal(l)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2]`, string(data))
+
+	decoded := MakeLinkedList[int]()
+	decoded.Add(9)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, []int{1, 2}, decoded.ToSlice())
+}
+
+func TestLinkedList_JSON_ZeroValue(t *testing.T) {
+	var holder struct {
+		L *LinkedList[string] `json:"l"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"l": ["a", "b"]}`), &holder))
+	assert.Equal(t, []string{"a", "b"}, holder.L.ToSlice())
+	assert.True(t, holder.L.Contains("b"))
+}
+
+func TestDoubleLinkedList_JSON(t *testing.T) {
+	l := MakeDoubleLinkedList[string]()
+	l.Add("x")
+
+	data, err := json.Marshal(l)
+	assert.NoError(t, err)
+	assert.Equal(t, `["x"]`, string(data))
+
+	empty, err := json.Marshal(MakeDoubleLinkedList[string]())
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(empty))
+
+	decoded := MakeDoubleLinkedList[string]()
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, []string{"x"}, decoded.ToSlice())
+	assert.Error(t, json.Unmarshal([]byte(`{}`), decoded))
+}
+
+func TestSimpleQueue_JSON_FifoOrder(t *testing.T) {
+	q := MakeSimpleQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	q.Dequeue()
+
+	data, err := json.Marshal(q)
+	assert.NoError(t, err)
+	assert.Equal(t, `[2,3]`, string(data))
+
+	decoded := MakeSimpleQueue[int]()
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	head, _ := decoded.Dequeue()
+	assert.Equal(t, 2, head)
+}
+
+func TestSimpleStack_JSON_BottomToTop(t *testing.T) {
+	s := MakeSimpleStack[int]()
+	s.Push(1)
+	s.Push(2)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2]`, string(data))
+
+	var decoded SimpleStack[int]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	top, _ := decoded.Pop()
+	assert.Equal(t, 2, top)
+	assert.Equal(t, 1, decoded.Size())
+}
@@ -1,8 +1,8 @@
 package list
 
 import (
-	"fmt"
-	"reflect"
+	"utils-generics/collections"
+	"utils-generics/collections/types"
 )
 
 type entry[T any] struct {
@@ -12,17 +12,32 @@ type entry[T any] struct {
 
 // LinkedList is a single linked list
 type LinkedList[T any] struct {
-	head *entry[T]
-	tail *entry[T] // we keep a pointer to the tail just to make adding elements faster
+	head   *entry[T]
+	tail   *entry[T] // we keep a pointer to the tail just to make adding elements faster
+	equals func(a, b T) bool
 }
 
-// MakeLinkedList returns a pointer to a new LinkedList
+// MakeLinkedList returns a pointer to a new LinkedList that compares values with reflect.DeepEqual
 func MakeLinkedList[T any]() *LinkedList[T] {
-	return &LinkedList[T]{nil, nil}
+	return MakeLinkedListWithEquals[T](types.DeepEquals[T])
+}
+
+// MakeLinkedListWithEquals returns a pointer to a new LinkedList that compares values with the provided function
+func MakeLinkedListWithEquals[T any](equals func(a, b T) bool) *LinkedList[T] {
+	return &LinkedList[T]{nil, nil, equals}
+}
+
+// MakeComparableLinkedList returns a pointer to a new LinkedList of comparable values, compared with ==
+func MakeComparableLinkedList[T comparable]() *LinkedList[T] {
+	return MakeLinkedListWithEquals[T](types.Equals[T])
 }
 
 // Add adds a new entry to the end of the list
 func (l *LinkedList[T]) Add(val T) {
+	if collections.Debug {
+		defer collections.MustBeValid(l)
+	}
+
 	newEntry := &entry[T]{val, nil}
 	if l.head == nil {
 		l.head = newEntry
@@ -43,7 +58,7 @@ func (l *LinkedList[T]) Add(val T) {
 
 // Get returns the value at the given index and true if the index is valid, otherwise 0 and false
 func (l *LinkedList[T]) Get(index int) (T, bool) {
-	if l.head == nil {
+	if l.head == nil || index < 0 {
 		var zero T
 		return zero, false
 	}
@@ -63,11 +78,15 @@ func (l *LinkedList[T]) Get(index int) (T, bool) {
 
 // Remove removes the first entry with the given value, return true if an entry was removed or false is not found
 func (l *LinkedList[T]) Remove(val T) bool {
+	if collections.Debug {
+		defer collections.MustBeValid(l)
+	}
+
 	if l.head == nil {
 		return false
 	}
 
-	if reflect.DeepEqual(l.head.val, val) {
+	if l.equals(l.head.val, val) {
 		l.head = l.head.next
 		if l.head == nil { // is this if necessary? me thinks not, but good to be safe
 			l.tail = nil
@@ -78,7 +97,7 @@ func (l *LinkedList[T]) Remove(val T) bool {
 	prev := l.head
 	current := l.head.next
 	for current != nil {
-		if reflect.DeepEqual(current.val, val) {
+		if l.equals(current.val, val) {
 			prev.next = current.next
 			if current == l.tail {
 				l.tail = prev
@@ -100,7 +119,7 @@ func (l *LinkedList[T]) Contains(val T) bool {
 
 	current := l.head
 	for current != nil {
-		if reflect.DeepEqual(current.val, val) {
+		if l.equals(current.val, val) {
 			return true
 		}
 		current = current.next
@@ -109,6 +128,15 @@ func (l *LinkedList[T]) Contains(val T) bool {
 	return false
 }
 
+// ToSlice returns a slice with the values of the list, in order
+func (l *LinkedList[T]) ToSlice() []T {
+	var values []T
+	for current := l.head; current != nil; current = current.next {
+		values = append(values, current.val)
+	}
+	return values
+}
+
 // Size returns the number of entries in the list
 func (l *LinkedList[T]) Size() int {
 	if l.head == nil {
@@ -140,20 +168,7 @@ func (l *LinkedList[T]) IsNotEmpty() bool {
 	return l.head != nil
 }
 
+// Formatted returns a string representation of the list
 func (l *LinkedList[T]) Formatted() string {
-	if l.head == nil {
-		return "[]"
-	}
-
-	current := l.head
-	s := "["
-	for current != nil {
-		s += fmt.Sprintf("%v", current.val)
-		if current.next != nil {
-			s += ", "
-		}
-		current = current.next
-	}
-	s += "]"
-	return s
+	return l.String()
 }
@@ -2,6 +2,7 @@ package list
 
 import (
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 )
 
@@ -111,3 +112,53 @@ func TestLinkedList_IsNotEmpty(t *testing.T) {
 	l.Add("pi")
 	assert.True(t, l.IsNotEmpty())
 }
+
+func TestLinkedList_ToSlice(t *testing.T) {
+	l := MakeLinkedList[int]()
+	assert.Empty(t, l.ToSlice())
+
+	l.Add(1)
+	l.Add(2)
+	l.Add(3)
+
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice())
+}
+
+func TestLinkedList_CustomEquals(t *testing.T) {
+	var l List[string] = MakeLinkedListWithEquals[string](strings.EqualFold)
+	l.Add("Hello")
+	l.Add("World")
+
+	assert.True(t, l.Contains("hello"))
+	assert.True(t, l.Remove("WORLD"))
+	assert.Equal(t, 1, l.Size())
+}
+
+func TestLinkedList_Comparable(t *testing.T) {
+	var l List[int] = MakeComparableLinkedList[int]()
+	l.Add(1)
+	l.Add(2)
+
+	assert.True(t, l.Contains(2))
+	assert.False(t, l.Contains(3))
+	assert.True(t, l.Remove(1))
+}
+
+func benchmarkLinkedListContains(b *testing.B, l List[int]) {
+	for i := 0; i < 256; i++ {
+		l.Add(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Contains(255)
+	}
+}
+
+func BenchmarkLinkedList_Contains_DeepEqual(b *testing.B) {
+	benchmarkLinkedListContains(b, MakeLinkedList[int]())
+}
+
+func BenchmarkLinkedList_Contains_Comparable(b *testing.B) {
+	benchmarkLinkedListContains(b, MakeComparableLinkedList[int]())
+}
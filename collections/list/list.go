@@ -24,3 +24,9 @@ type Stack[T any] interface {
 	Pop() (T, bool)
 	Peek() (T, bool)
 }
+
+// ReadOnlyStack is the subset of Stack that never modifies the stack, satisfied by immutable stacks as well
+type ReadOnlyStack[T any] interface {
+	collections.ReadOnlyCollection
+	Peek() (T, bool)
+}
@@ -50,7 +50,7 @@ func (q *SimpleQueue[T]) IsNotEmpty() bool {
 	return len(q.elements) > 0
 }
 
+// Formatted returns a string representation of the queue, in fifo order
 func (q *SimpleQueue[T]) Formatted() string {
-	// TODO print out the list in fifo order
-	return ""
+	return q.String()
 }
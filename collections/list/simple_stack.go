@@ -50,7 +50,7 @@ func (s *SimpleStack[T]) IsNotEmpty() bool {
 	return len(s.elements) > 0
 }
 
+// Formatted returns a string representation of the stack, from the top to the bottom
 func (s *SimpleStack[T]) Formatted() string {
-	// TODO print out the list in fifo order
-	return ""
+	return s.String()
 }
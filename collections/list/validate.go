@@ -0,0 +1,46 @@
+package list
+
+import "errors"
+
+// File: validate.go
+// Checking of the internal invariants of the linked lists, see collections.Validator.
+
+// Validate checks that the entries do not loop and that the tail is the last entry
+func (l *LinkedList[T]) Validate() error {
+	// the fast pointer moves two entries at a time, meeting the slow one again only if the entries loop
+	for slow, fast := l.head, l.head; fast != nil && fast.next != nil; {
+		slow, fast = slow.next, fast.next.next
+		if slow == fast {
+			return errors.New("list: LinkedList entries loop")
+		}
+	}
+
+	var last *entry[T]
+	for current := l.head; current != nil; current = current.next {
+		last = current
+	}
+	if l.tail != last {
+		return errors.New("list: LinkedList tail is not its last entry")
+	}
+	return nil
+}
+
+// Validate checks that every prev link mirrors the next link pointing at the entry and that the head and the tail
+// are the first and the last entries. The entries cannot loop without breaking one of the prev links.
+func (l *DoubleLinkedList[T]) Validate() error {
+	if l.head != nil && l.head.prev != nil {
+		return errors.New("list: DoubleLinkedList head has a previous entry")
+	}
+
+	var last *biDirectionalEntry[T]
+	for current := l.head; current != nil; current = current.next {
+		if current.next != nil && current.next.prev != current {
+			return errors.New("list: DoubleLinkedList prev link does not mirror the next link")
+		}
+		last = current
+	}
+	if l.tail != last {
+		return errors.New("list: DoubleLinkedList tail is not its last entry")
+	}
+	return nil
+}
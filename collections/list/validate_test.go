@@ -0,0 +1,47 @@
+package list
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLinkedList_Validate(t *testing.T) {
+	l := MakeComparableLinkedList[int]()
+	assert.NoError(t, l.Validate())
+	for i := 0; i < 5; i++ {
+		l.Add(i)
+	}
+	l.Remove(4)
+	l.Remove(0)
+	assert.NoError(t, l.Validate())
+
+	l.tail = l.head
+	assert.EqualError(t, l.Validate(), "list: LinkedList tail is not its last entry")
+
+	l.tail = l.head.next.next
+	l.tail.next = l.head.next
+	assert.EqualError(t, l.Validate(), "list: LinkedList entries loop")
+}
+
+func TestDoubleLinkedList_Validate(t *testing.T) {
+	l := MakeComparableDoubleLinkedList[int]()
+	assert.NoError(t, l.Validate())
+	for i := 0; i < 5; i++ {
+		l.Add(i)
+	}
+	l.Remove(4)
+	l.Remove(0)
+	l.Remove(2)
+	assert.NoError(t, l.Validate())
+
+	l.tail = l.head
+	assert.EqualError(t, l.Validate(), "list: DoubleLinkedList tail is not its last entry")
+
+	l.tail = l.head.next
+	l.tail.prev = nil
+	assert.EqualError(t, l.Validate(), "list: DoubleLinkedList prev link does not mirror the next link")
+
+	l.tail.prev = l.head
+	l.tail.next = l.head
+	assert.Error(t, l.Validate())
+}
@@ -0,0 +1,6 @@
+//go:build !collections_debug
+
+package collections
+
+// Debug is true when built with the collections_debug tag, see debug.go
+const Debug = false
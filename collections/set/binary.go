@@ -0,0 +1,200 @@
+package set
+
+import (
+	"utils-generics/collections/codec"
+	"utils-generics/collections/dict"
+)
+
+// File: binary.go
+// Binary encoding of the sets, see the codec package for the format.
+//
+// Sets use the Values layout, sorted sets in order so they are reloaded in O(n), and multisets the Counted layout.
+// Like for JSON, sets must be created with their Make function before decoding into them,
+// and decoding replaces their contents.
+
+// decodeInto decodes values and replaces the elements of the set with them
+func decodeInto[K any](data []byte, c codec.Codec[K], s Set[K]) error {
+	elements, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, val := range elements {
+		s.Add(val)
+	}
+	return nil
+}
+
+// decodeSorted decodes values written in order, as entries of the inner map of a sorted set
+func decodeSorted[K any](data []byte, c codec.Codec[K]) ([]dict.Entry[K, bool], error) {
+	elements, err := codec.DecodeValues(data, c)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]dict.Entry[K, bool], len(elements))
+	for i, val := range elements {
+		entries[i] = dict.Entry[K, bool]{Key: val, Val: true}
+	}
+	return entries, nil
+}
+
+// MarshalBinary encodes the set with the default codec of its elements
+func (s *HashSet[K]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K]())
+}
+
+// UnmarshalBinary replaces the elements of the set with the ones decoded with the default codec.
+// The set must have been created with one of the Make functions, as its hasher cannot be decoded.
+func (s *HashSet[K]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K]())
+}
+
+// EncodeBinary encodes the set with the given codec
+func (s *HashSet[K]) EncodeBinary(c codec.Codec[K]) ([]byte, error) {
+	return codec.EncodeValues(s.innerMap.Keys(), c)
+}
+
+// DecodeBinary replaces the elements of the set with the ones decoded with the given codec
+func (s *HashSet[K]) DecodeBinary(data []byte, c codec.Codec[K]) error {
+	if s.innerMap == nil {
+		return errNotConstructed("HashSet")
+	}
+	return decodeInto[K](data, c, s)
+}
+
+// MarshalBinary encodes the set in order with the default codec of its elements
+func (s *BinaryTreeSet[K]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K]())
+}
+
+// UnmarshalBinary replaces the elements of the set with the ones decoded with the default codec.
+// The set must have been created with MakeBinaryTreeSet, as its comparator cannot be decoded.
+func (s *BinaryTreeSet[K]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K]())
+}
+
+// EncodeBinary encodes the set in order with the given codec
+func (s *BinaryTreeSet[K]) EncodeBinary(c codec.Codec[K]) ([]byte, error) {
+	return codec.EncodeValues(s.innerMap.Keys(), c)
+}
+
+// DecodeBinary replaces the elements of the set with the ones decoded with the given codec.
+// The elements are expected in order, as written by EncodeBinary, which allows to rebuild the tree in O(n).
+func (s *BinaryTreeSet[K]) DecodeBinary(data []byte, c codec.Codec[K]) error {
+	if s.innerMap == nil {
+		return errNotConstructed("BinaryTreeSet")
+	}
+	entries, err := decodeSorted(data, c)
+	if err != nil {
+		return err
+	}
+	return s.innerMap.LoadSorted(entries)
+}
+
+// MarshalBinary encodes the set in order with the default codec of its elements
+func (s *FlatSet[K]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K]())
+}
+
+// UnmarshalBinary replaces the elements of the set with the ones decoded with the default codec.
+// The set must have been created with MakeFlatSet, as its comparator cannot be decoded.
+func (s *FlatSet[K]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K]())
+}
+
+// EncodeBinary encodes the set in order with the given codec
+func (s *FlatSet[K]) EncodeBinary(c codec.Codec[K]) ([]byte, error) {
+	return codec.EncodeValues(s.innerMap.Keys(), c)
+}
+
+// DecodeBinary replaces the elements of the set with the ones decoded with the given codec.
+// The elements are expected in order, as written by EncodeBinary, so they are loaded in O(n) without sorting.
+func (s *FlatSet[K]) DecodeBinary(data []byte, c codec.Codec[K]) error {
+	if s.innerMap == nil {
+		return errNotConstructed("FlatSet")
+	}
+	entries, err := decodeSorted(data, c)
+	if err != nil {
+		return err
+	}
+	return s.innerMap.LoadSorted(entries)
+}
+
+// MarshalBinary encodes the set in order with the default codec of its elements
+func (s *SkipListSet[K]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K]())
+}
+
+// UnmarshalBinary replaces the elements of the set with the ones decoded with the default codec.
+// The set must have been created with one of the Make functions, as its comparator cannot be decoded.
+func (s *SkipListSet[K]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K]())
+}
+
+// EncodeBinary encodes the set in order with the given codec
+func (s *SkipListSet[K]) EncodeBinary(c codec.Codec[K]) ([]byte, error) {
+	return codec.EncodeValues(s.innerMap.Keys(), c)
+}
+
+// DecodeBinary replaces the elements of the set with the ones decoded with the given codec.
+// The elements are expected in order, as written by EncodeBinary, so each one is appended without a search.
+func (s *SkipListSet[K]) DecodeBinary(data []byte, c codec.Codec[K]) error {
+	if s.innerMap == nil {
+		return errNotConstructed("SkipListSet")
+	}
+	entries, err := decodeSorted(data, c)
+	if err != nil {
+		return err
+	}
+	return s.innerMap.LoadSorted(entries)
+}
+
+// MarshalBinary encodes the multiset with the default codec of its elements
+func (s *multiset[K]) MarshalBinary() ([]byte, error) {
+	return s.EncodeBinary(codec.Default[K]())
+}
+
+// UnmarshalBinary replaces the elements of the multiset with the ones decoded with the default codec.
+// The multiset must have been created with its Make function, as its hasher or comparator cannot be decoded.
+func (s *multiset[K]) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(data, codec.Default[K]())
+}
+
+// EncodeBinary encodes the distinct elements of the multiset along with their counts, using the given codec
+func (s *multiset[K]) EncodeBinary(c codec.Codec[K]) ([]byte, error) {
+	entries := s.EntrySet()
+	e := codec.NewEncoder(codec.Counted, len(entries))
+	for _, entry := range entries {
+		codec.Write(e, c, entry.Val)
+		e.WriteCount(entry.Count)
+	}
+	return e.Bytes()
+}
+
+// DecodeBinary replaces the elements of the multiset with the ones decoded with the given codec
+func (s *multiset[K]) DecodeBinary(data []byte, c codec.Codec[K]) error {
+	if s.counts == nil {
+		return errNotConstructed("multiset")
+	}
+	d, n, err := codec.NewDecoder(data, codec.Counted)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]MultisetEntry[K], 0, n)
+	for i := 0; i < n && d.Err() == nil; i++ {
+		val := codec.Read(d, c)
+		entries = append(entries, MultisetEntry[K]{Val: val, Count: d.ReadCount()})
+	}
+	if err := d.Finish(); err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Add(entry.Val, entry.Count)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/codec"
+	"utils-generics/collections/types"
+)
+
+func TestHashSet_Binary(t *testing.T) {
+	s := MakeHashSet[int](types.IntHash)
+	s.Add(-5)
+	s.Add(10)
+
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeHashSet[int](types.IntHash)
+	decoded.Add(1)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, 2, decoded.Size())
+	assert.True(t, decoded.Contains(-5))
+	assert.False(t, decoded.Contains(1))
+
+	assert.Error(t, (&HashSet[int]{}).UnmarshalBinary(data))
+}
+
+func TestBinaryTreeSet_Binary(t *testing.T) {
+	s := MakeBinaryTreeSet[string](types.StringComparator)
+	for _, v := range []string{"c", "a", "b"} {
+		s.Add(v)
+	}
+
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{codec.Version, byte(codec.Values), 3, 1, 'a', 1, 'b', 1, 'c'}, data)
+
+	decoded := MakeBinaryTreeSet[string](types.StringComparator)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []string{"a", "b", "c"}, decoded.ToSortedSlice())
+	assert.True(t, decoded.Contains("b"))
+
+	unsorted, _ := codec.EncodeValues([]string{"b", "a"}, codec.String[string]())
+	assert.Error(t, decoded.UnmarshalBinary(unsorted))
+}
+
+func TestSkipListSet_Binary(t *testing.T) {
+	s := MakeSkipListSet[string](types.StringComparator)
+	for _, v := range []string{"c", "a", "b"} {
+		s.Add(v)
+	}
+
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeSkipListSet[string](types.StringComparator)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []string{"a", "b", "c"}, decoded.ToSortedSlice())
+	assert.NoError(t, decoded.Validate())
+
+	unsorted, _ := codec.EncodeValues([]string{"b", "a"}, codec.String[string]())
+	assert.Error(t, decoded.UnmarshalBinary(unsorted))
+	assert.Error(t, (&SkipListSet[string]{}).UnmarshalBinary(data))
+}
+
+func TestFlatSet_Binary(t *testing.T) {
+	s := MakeFlatSet[float64](types.Float64Comparator)
+	s.Add(2.5)
+	s.Add(-1)
+
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeFlatSet[float64](types.Float64Comparator)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, 2, decoded.Size())
+	assert.True(t, decoded.Contains(-1))
+}
+
+func TestTreeMultiset_Binary(t *testing.T) {
+	s := MakeTreeMultiset[string](types.StringComparator)
+	s.Add("a", 1000)
+	s.Add("b", 1)
+
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := MakeTreeMultiset[string](types.StringComparator)
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, 1001, decoded.Size())
+	assert.Equal(t, 1000, decoded.Count("a"))
+
+	assert.Error(t, decoded.UnmarshalBinary(data[:len(data)-1]))
+	assert.Equal(t, 1001, decoded.Size())
+}
+
+func TestHashMultiset_Gob(t *testing.T) {
+	s := MakeHashMultiset[int](types.IntHash)
+	s.Add(3, 2)
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(s))
+
+	decoded := MakeHashMultiset[int](types.IntHash)
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	assert.Equal(t, 2, decoded.Count(3))
+}
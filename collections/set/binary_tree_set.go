@@ -1,9 +1,6 @@
 package set
 
-import (
-	"fmt"
-	"utils-generics/collections/dict"
-)
+import "utils-generics/collections/dict"
 
 // BinaryTreeSet is a set implementation using a binary tree map -- roughly speaking it implements a binary tree solution.
 //
@@ -64,22 +61,9 @@ func (s *BinaryTreeSet[K]) IsNotEmpty() bool {
 	return s.innerMap.IsNotEmpty()
 }
 
-// Formatted returns a string representation of the set
+// Formatted returns a string representation of the set, in order
 func (s *BinaryTreeSet[K]) Formatted() string {
-	entries := s.innerMap.Entries()
-	str := "{"
-
-	for _, entry := range entries {
-		str += fmt.Sprintf("%T, ", entry.Key)
-	}
-
-	// remove last comma and space str if it exists
-	if len(str) > 1 {
-		str = str[:len(str)-2]
-	}
-
-	str += "}"
-	return str
+	return s.String()
 }
 
 // ----------------
@@ -112,6 +96,43 @@ func (s *BinaryTreeSet[K]) ToSortedSlice() []K {
 	return s.innerMap.Keys()
 }
 
+// ----------------
+// NavigableSet methods
+
+// Floor returns the greatest element less than or equal to the given element, if any
+func (s *BinaryTreeSet[K]) Floor(val K) (K, bool) {
+	entry, ok := s.innerMap.Floor(val)
+	return entry.Key, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to the given element, if any
+func (s *BinaryTreeSet[K]) Ceiling(val K) (K, bool) {
+	entry, ok := s.innerMap.Ceiling(val)
+	return entry.Key, ok
+}
+
+// Lower returns the greatest element strictly less than the given element, if any
+func (s *BinaryTreeSet[K]) Lower(val K) (K, bool) {
+	entry, ok := s.innerMap.Lower(val)
+	return entry.Key, ok
+}
+
+// Higher returns the smallest element strictly greater than the given element, if any
+func (s *BinaryTreeSet[K]) Higher(val K) (K, bool) {
+	entry, ok := s.innerMap.Higher(val)
+	return entry.Key, ok
+}
+
+// Range returns, in order, the elements in the half-open interval [from, to)
+func (s *BinaryTreeSet[K]) Range(from, to K) []K {
+	entries := s.innerMap.Range(from, to)
+	keys := make([]K, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, entry.Key)
+	}
+	return keys
+}
+
 // ----------------
 // Specialized methods
 
@@ -117,3 +117,58 @@ func TestBinaryTreeSet_RemoveLast(t *testing.T) {
 	assert.Equal(t, 1, s.Size())
 	assert.Equal(t, 1, s.Last())
 }
+
+// navigable set operations
+
+func TestBinaryTreeSet_FloorAndCeiling(t *testing.T) {
+	var s NavigableSet[int] = MakeBinaryTreeSet[int](types.IntComparator)
+	s.Add(10)
+	s.Add(20)
+	s.Add(30)
+
+	val, ok := s.Floor(25)
+	assert.True(t, ok)
+	assert.Equal(t, 20, val)
+
+	val, ok = s.Floor(30)
+	assert.True(t, ok)
+	assert.Equal(t, 30, val)
+
+	_, ok = s.Floor(5)
+	assert.False(t, ok)
+
+	val, ok = s.Ceiling(11)
+	assert.True(t, ok)
+	assert.Equal(t, 20, val)
+
+	_, ok = s.Ceiling(31)
+	assert.False(t, ok)
+}
+
+func TestBinaryTreeSet_LowerAndHigher(t *testing.T) {
+	var s NavigableSet[int] = MakeBinaryTreeSet[int](types.IntComparator)
+	s.Add(10)
+	s.Add(20)
+	s.Add(30)
+
+	val, ok := s.Lower(20)
+	assert.True(t, ok)
+	assert.Equal(t, 10, val)
+
+	val, ok = s.Higher(20)
+	assert.True(t, ok)
+	assert.Equal(t, 30, val)
+
+	_, ok = s.Higher(30)
+	assert.False(t, ok)
+}
+
+func TestBinaryTreeSet_Range(t *testing.T) {
+	var s NavigableSet[int] = MakeBinaryTreeSet[int](types.IntComparator)
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		s.Add(v)
+	}
+
+	assert.Equal(t, []int{30, 40, 50, 60}, s.Range(30, 70))
+	assert.Empty(t, s.Range(90, 100))
+}
@@ -11,6 +11,21 @@ func MakeFlatSet[K any](c func(a, b K) int) *FlatSet[K] {
 	return &FlatSet[K]{innerMap: dict.MakeFlatMap[K, bool](c)}
 }
 
+// MakeFlatSetFrom creates a new FlatSet holding the given elements, in any order and possibly repeated.
+// They are sorted once in O(n log n) instead of being added one by one in O(n²).
+func MakeFlatSetFrom[K any](c func(a, b K) int, values []K) *FlatSet[K] {
+	return &FlatSet[K]{innerMap: dict.MakeFlatMapFrom(c, setEntries(values))}
+}
+
+// setEntries returns the entries of the inner map of a set holding the values
+func setEntries[K any](values []K) []dict.Entry[K, bool] {
+	entries := make([]dict.Entry[K, bool], len(values))
+	for i, val := range values {
+		entries[i] = dict.Entry[K, bool]{Key: val, Val: true}
+	}
+	return entries
+}
+
 // Add adds a new element to the set.
 // This operation is idempotent, so if the element already exists in the set, it is equivalent to a no-op.
 func (s *FlatSet[K]) Add(val K) {
@@ -23,6 +38,16 @@ func (s *FlatSet[K]) Remove(val K) bool {
 	return s.innerMap.Remove(val)
 }
 
+// AddAll adds all the elements to the set, merging them in O(n + m) for m sorted elements, see dict.FlatMap.PutAll
+func (s *FlatSet[K]) AddAll(values []K) {
+	s.innerMap.PutAll(setEntries(values))
+}
+
+// RemoveIf removes all the elements that match the predicate in O(n), returning how many were removed
+func (s *FlatSet[K]) RemoveIf(predicate func(val K) bool) int {
+	return s.innerMap.RemoveIf(func(key K, _ bool) bool { return predicate(key) })
+}
+
 // Contains returns true if the element exists in the set, otherwise it returns false.
 func (s *FlatSet[K]) Contains(val K) bool {
 	_, ok := s.innerMap.Get(val)
@@ -46,7 +71,7 @@ func (s *FlatSet[K]) Clear() {
 	s.innerMap.Clear()
 }
 
+// Formatted returns a string representation of the set, in order.
 func (s *FlatSet[K]) Formatted() string {
-	//TODO implement me
-	panic("implement me")
+	return s.String()
 }
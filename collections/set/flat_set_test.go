@@ -73,3 +73,24 @@ func TestFlatSet_RemoveNonExistent(t *testing.T) {
 	assert.False(t, ok)
 	assert.Equal(t, 2, s.Size())
 }
+
+func TestMakeFlatSetFrom(t *testing.T) {
+	s := MakeFlatSetFrom(types.IntComparator, []int{3, 1, 2, 3, 1})
+
+	assert.Equal(t, 3, s.Size())
+	assert.Equal(t, "{1, 2, 3}", s.String())
+}
+
+func TestFlatSet_AddAll(t *testing.T) {
+	s := MakeFlatSetFrom(types.IntComparator, []int{1, 3})
+	s.AddAll([]int{4, 2, 3})
+
+	assert.Equal(t, "{1, 2, 3, 4}", s.String())
+}
+
+func TestFlatSet_RemoveIf(t *testing.T) {
+	s := MakeFlatSetFrom(types.IntComparator, []int{1, 2, 3, 4, 5})
+
+	assert.Equal(t, 2, s.RemoveIf(func(val int) bool { return val%2 == 0 }))
+	assert.Equal(t, "{1, 3, 5}", s.String())
+}
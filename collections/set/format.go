@@ -0,0 +1,95 @@
+package set
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: format.go
+// Printing of the sets through fmt, see collections.Format for the supported verbs and flags.
+
+// setContents describes the elements of a set for collections.Format
+func setContents[K any](elements []K) collections.Contents {
+	return collections.Contents{Size: len(elements), Open: "{", Close: "}",
+		ValType: collections.TypeOf[K](),
+		Each: func(visit func(key, val any) bool) {
+			for _, val := range elements {
+				if !visit(nil, val) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// String returns the elements of the set, as printed by the %v verb
+func (s *HashSet[K]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter
+func (s *HashSet[K]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, setContents(s.innerMap.Keys()))
+}
+
+// String returns the elements of the set in order, as printed by the %v verb
+func (s *BinaryTreeSet[K]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the elements in order
+func (s *BinaryTreeSet[K]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, setContents(s.innerMap.Keys()))
+}
+
+// String returns the elements of the set in order, as printed by the %v verb
+func (s *FlatSet[K]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the elements in order
+func (s *FlatSet[K]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, setContents(s.innerMap.Keys()))
+}
+
+// String returns the elements of the set in order, as printed by the %v verb
+func (s *SkipListSet[K]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing the elements in order
+func (s *SkipListSet[K]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, setContents(s.innerMap.Keys()))
+}
+
+// Formatted returns a string representation of the multiset, with each element followed by its count
+func (s *HashMultiset[K]) Formatted() string {
+	return s.String()
+}
+
+// String returns the distinct elements of the multiset followed by their counts, as printed by the %v verb
+func (s *HashMultiset[K]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing each distinct element followed by its count.
+// The size printed by %+v is the number of distinct elements.
+func (s *HashMultiset[K]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, s.contents())
+}
+
+// Formatted returns a string representation of the multiset in order, with each element followed by its count
+func (s *TreeMultiset[K]) Formatted() string {
+	return s.String()
+}
+
+// String returns the distinct elements of the multiset in order followed by their counts, as printed by the %v verb
+func (s *TreeMultiset[K]) String() string {
+	return fmt.Sprint(s)
+}
+
+// Format implements fmt.Formatter, printing each distinct element in order followed by its count.
+// The size printed by %+v is the number of distinct elements.
+func (s *TreeMultiset[K]) Format(f fmt.State, verb rune) {
+	collections.Format(f, verb, s, s.contents())
+}
@@ -0,0 +1,50 @@
+package set
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestHashSet_Format(t *testing.T) {
+	s := MakeDefaultHashSet[string]()
+	s.Add("a")
+
+	assert.Equal(t, "{a}", s.String())
+	assert.Equal(t, `[]string{"a"}`, fmt.Sprintf("%#v", s))
+}
+
+func TestBinaryTreeSet_Format(t *testing.T) {
+	s := MakeBinaryTreeSet[int](types.IntComparator)
+	for _, v := range []int{3, 1, 2, 5, 4} {
+		s.Add(v)
+	}
+
+	assert.Equal(t, "{1, 2, 3, 4, 5}", s.Formatted())
+	assert.Equal(t, "{1, 2, ... 3 more}", fmt.Sprintf("%.2v", s))
+	assert.Equal(t, "set.BinaryTreeSet[int] size=5 {1, 2, 3, 4, 5}", fmt.Sprintf("%+v", s))
+}
+
+func TestFlatSet_Format(t *testing.T) {
+	s := MakeFlatSet[int](types.IntComparator)
+	s.Add(255)
+	s.Add(10)
+
+	assert.Equal(t, "{10, 255}", s.Formatted())
+	assert.Equal(t, "{a, ff}", fmt.Sprintf("%x", s))
+}
+
+func TestMultiset_Format(t *testing.T) {
+	s := MakeTreeMultiset[string](types.StringComparator)
+	s.Add("b", 1)
+	s.Add("a", 2)
+
+	assert.Equal(t, "{a: 2, b: 1}", s.Formatted())
+	assert.Equal(t, "set.TreeMultiset[string] size=2 {a: 2, b: 1}", fmt.Sprintf("%+v", s))
+	assert.Equal(t, `map[string]int{"a": 2, "b": 1}`, fmt.Sprintf("%#v", s))
+
+	h := MakeHashMultiset[string](types.StringHash)
+	h.Add("a", 1)
+	assert.Equal(t, "{a: 1}", h.String())
+}
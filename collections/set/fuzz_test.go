@@ -0,0 +1,106 @@
+package set
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"utils-generics/collections"
+	"utils-generics/collections/types"
+)
+
+// fuzzSet decodes the fuzz input two bytes at a time, an operation and an element, and runs the operations against
+// the set and a builtin map, failing as soon as they disagree or the set breaks one of its invariants.
+// The operation byte 0xff clears the set. Ordered sets also get RemoveFirst and RemoveLast operations.
+func fuzzSet(t *testing.T, s Set[byte], data []byte) {
+	ordered, _ := s.(OrderedSet[byte])
+	model := map[byte]bool{}
+	for i := 0; i+1 < len(data); i += 2 {
+		code, val := data[i], data[i+1]
+		switch {
+		case code == 0xff:
+			s.Clear()
+			model = map[byte]bool{}
+		case code%8 < 3:
+			s.Add(val)
+			model[val] = true
+		case code%8 < 5:
+			if removed := s.Remove(val); removed != model[val] {
+				t.Fatalf("operation %d: Remove(%d) = %t, expected %t", i/2, val, removed, model[val])
+			}
+			delete(model, val)
+		case code%8 == 5 || ordered == nil:
+			if found := s.Contains(val); found != model[val] {
+				t.Fatalf("operation %d: Contains(%d) = %t, expected %t", i/2, val, found, model[val])
+			}
+		default:
+			sorted := sortedModel(model)
+			var removed bool
+			if code%8 == 6 {
+				removed = ordered.RemoveFirst()
+				if len(sorted) > 0 {
+					delete(model, sorted[0])
+				}
+			} else {
+				removed = ordered.RemoveLast()
+				if len(sorted) > 0 {
+					delete(model, sorted[len(sorted)-1])
+				}
+			}
+			if removed != (len(sorted) > 0) {
+				t.Fatalf("operation %d: removing an end of %d elements returned %t", i/2, len(sorted), removed)
+			}
+		}
+
+		if s.Size() != len(model) {
+			t.Fatalf("operation %d: Size() = %d, expected %d", i/2, s.Size(), len(model))
+		}
+		if err := s.(collections.Validator).Validate(); err != nil {
+			t.Fatalf("operation %d: %v", i/2, err)
+		}
+	}
+
+	for val := 0; val < 256; val++ {
+		if s.Contains(byte(val)) != model[byte(val)] {
+			t.Fatalf("Contains(%d) = %t", val, !model[byte(val)])
+		}
+	}
+	if ordered != nil {
+		sorted := sortedModel(model)
+		if slice := ordered.ToSortedSlice(); !bytes.Equal(slice, sorted) {
+			t.Fatalf("ToSortedSlice() = %v, expected %v", slice, sorted)
+		}
+	}
+}
+
+func sortedModel(model map[byte]bool) []byte {
+	sorted := make([]byte, 0, len(model))
+	for val := range model {
+		sorted = append(sorted, val)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func FuzzHashSet(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzSet(t, MakeHashSet[byte](types.ByteHash), data)
+	})
+}
+
+func FuzzBinaryTreeSet(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzSet(t, MakeBinaryTreeSet[byte](types.ByteComparator), data)
+	})
+}
+
+func FuzzFlatSet(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzSet(t, MakeFlatSet[byte](types.ByteComparator), data)
+	})
+}
+
+func FuzzSkipListSet(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzSet(t, MakeSkipListSetWithSeed[byte](types.ByteComparator, 1), data)
+	})
+}
@@ -0,0 +1,57 @@
+package set
+
+import "utils-generics/collections/dict"
+
+// HashMultiset is a multiset implementation using a hash map to keep the count of each element.
+//
+// It makes no guarantees on ordering of its elements.
+// It is not thread safe and should not be used for concurrent access
+// (see concurrent package for thread safe implementations).
+//
+// It's performance characteristics are:
+//
+// - Add: O(1)
+//
+// - Remove: O(1)
+//
+// - Count: O(1)
+type HashMultiset[K any] struct {
+	multiset[K]
+	hasher func(K) int
+}
+
+// MakeHashMultiset creates a new HashMultiset.
+func MakeHashMultiset[K any](h func(K) int) *HashMultiset[K] {
+	return &HashMultiset[K]{multiset: multiset[K]{counts: dict.MakeHashMap[K, int](h)}, hasher: h}
+}
+
+// ElementSet returns a HashSet with the distinct elements of the multiset.
+// The set is a copy, so changes to it are not reflected on the multiset.
+func (s *HashMultiset[K]) ElementSet() Set[K] {
+	elements := MakeHashSet[K](s.hasher)
+	for _, key := range s.counts.Keys() {
+		elements.Add(key)
+	}
+	return elements
+}
+
+// Union returns a new multiset where each element occurs the maximum of the times it occurs in both multisets.
+func (s *HashMultiset[K]) Union(other Multiset[K]) Multiset[K] {
+	result := MakeHashMultiset[K](s.hasher)
+	result.union(s, other)
+	return result
+}
+
+// Intersection returns a new multiset where each element occurs the minimum of the times it occurs in both multisets.
+func (s *HashMultiset[K]) Intersection(other Multiset[K]) Multiset[K] {
+	result := MakeHashMultiset[K](s.hasher)
+	result.intersection(s, other)
+	return result
+}
+
+// Sum returns a new multiset where each element occurs the sum of the times it occurs in both multisets.
+func (s *HashMultiset[K]) Sum(other Multiset[K]) Multiset[K] {
+	result := MakeHashMultiset[K](s.hasher)
+	result.sum(s, other)
+	return result
+}
@@ -0,0 +1,106 @@
+package set
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestHashMultiset_Add(t *testing.T) {
+	var s Multiset[string] = MakeHashMultiset[string](types.StringHash)
+	s.Add("a", 2)
+	s.Add("b", 1)
+	s.Add("a", 1)
+
+	assert.Equal(t, 4, s.Size())
+	assert.Equal(t, 3, s.Count("a"))
+	assert.Equal(t, 1, s.Count("b"))
+	assert.Equal(t, 0, s.Count("c"))
+}
+
+func TestHashMultiset_AddNegativePanics(t *testing.T) {
+	var s Multiset[string] = MakeHashMultiset[string](types.StringHash)
+
+	assert.Panics(t, func() { s.Add("a", -1) })
+}
+
+func TestHashMultiset_Remove(t *testing.T) {
+	var s Multiset[string] = MakeHashMultiset[string](types.StringHash)
+	s.Add("a", 3)
+
+	assert.True(t, s.Remove("a", 2))
+	assert.Equal(t, 1, s.Count("a"))
+	assert.True(t, s.Remove("a", 5))
+	assert.False(t, s.Contains("a"))
+	assert.False(t, s.Remove("a", 1))
+	assert.True(t, s.IsEmpty())
+}
+
+func TestHashMultiset_SetCount(t *testing.T) {
+	var s Multiset[string] = MakeHashMultiset[string](types.StringHash)
+	s.Add("a", 3)
+	s.SetCount("a", 5)
+	s.SetCount("b", 2)
+
+	assert.Equal(t, 7, s.Size())
+
+	s.SetCount("a", 0)
+	assert.False(t, s.Contains("a"))
+	assert.Equal(t, 2, s.Size())
+}
+
+func TestHashMultiset_ElementSet(t *testing.T) {
+	var s Multiset[string] = MakeHashMultiset[string](types.StringHash)
+	s.Add("a", 3)
+	s.Add("b", 1)
+
+	elements := s.ElementSet()
+	assert.Equal(t, 2, elements.Size())
+	assert.True(t, elements.Contains("a"))
+	assert.True(t, elements.Contains("b"))
+}
+
+func TestHashMultiset_MostCommon(t *testing.T) {
+	var s Multiset[string] = MakeHashMultiset[string](types.StringHash)
+	s.Add("a", 1)
+	s.Add("b", 5)
+	s.Add("c", 3)
+
+	assert.Equal(t, []MultisetEntry[string]{{Val: "b", Count: 5}, {Val: "c", Count: 3}}, s.MostCommon(2))
+	assert.Len(t, s.MostCommon(-1), 3)
+	assert.Len(t, s.MostCommon(10), 3)
+}
+
+func TestHashMultiset_Operations(t *testing.T) {
+	a := MakeHashMultiset[string](types.StringHash)
+	a.Add("x", 3)
+	a.Add("y", 1)
+	b := MakeHashMultiset[string](types.StringHash)
+	b.Add("x", 1)
+	b.Add("z", 2)
+
+	union := a.Union(b)
+	assert.Equal(t, 3, union.Count("x"))
+	assert.Equal(t, 1, union.Count("y"))
+	assert.Equal(t, 2, union.Count("z"))
+	assert.Equal(t, 6, union.Size())
+
+	intersection := a.Intersection(b)
+	assert.Equal(t, 1, intersection.Count("x"))
+	assert.False(t, intersection.Contains("y"))
+	assert.False(t, intersection.Contains("z"))
+	assert.Equal(t, 1, intersection.Size())
+
+	sum := a.Sum(b)
+	assert.Equal(t, 4, sum.Count("x"))
+	assert.Equal(t, 7, sum.Size())
+}
+
+func TestHashMultiset_Clear(t *testing.T) {
+	var s Multiset[string] = MakeHashMultiset[string](types.StringHash)
+	s.Add("a", 3)
+	s.Clear()
+
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 0, s.Count("a"))
+}
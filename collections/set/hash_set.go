@@ -1,8 +1,8 @@
 package set
 
 import (
-	"fmt"
 	"utils-generics/collections/dict"
+	"utils-generics/collections/types"
 )
 
 // HashSet is a set implementation using a hash map -- roughly speaking it implements a hash table solution.
@@ -22,11 +22,36 @@ type HashSet[K any] struct {
 	innerMap *dict.HashMap[K, bool]
 }
 
-// MakeHashSet creates a new HashSet.
+// MakeHashSet creates a new HashSet that compares elements with reflect.DeepEqual.
 func MakeHashSet[K any](h func(K) int) *HashSet[K] {
 	return &HashSet[K]{innerMap: dict.MakeHashMap[K, bool](h)}
 }
 
+// MakeHashSetWithEquals creates a new HashSet that compares elements with the provided function.
+func MakeHashSetWithEquals[K any](h func(K) int, equals func(a, b K) bool) *HashSet[K] {
+	return &HashSet[K]{innerMap: dict.MakeHashMapWithEquals[K, bool](h, equals)}
+}
+
+// MakeComparableHashSet creates a new HashSet for comparable elements, comparing them with ==.
+func MakeComparableHashSet[K comparable](h func(K) int) *HashSet[K] {
+	return &HashSet[K]{innerMap: dict.MakeComparableHashMap[K, bool](h)}
+}
+
+// MakeHashSetWithHasher creates a new HashSet that hashes and compares elements with the provided Hasher.
+func MakeHashSetWithHasher[K any](h types.Hasher[K]) *HashSet[K] {
+	return &HashSet[K]{innerMap: dict.MakeHashMapWithHasher[K, bool](h)}
+}
+
+// MakeDefaultHashSet creates a new HashSet for string or integer elements, hashed with a randomly seeded hasher.
+func MakeDefaultHashSet[K comparable]() *HashSet[K] {
+	return &HashSet[K]{innerMap: dict.MakeDefaultHashMap[K, bool]()}
+}
+
+// MakeHashableSet creates a new HashSet for elements that implement types.Hashable.
+func MakeHashableSet[K types.Hashable[K]]() *HashSet[K] {
+	return &HashSet[K]{innerMap: dict.MakeHashableMap[K, bool]()}
+}
+
 // Add adds a new element to the set.
 // This operation is idempotent, so if the element already exists in the set, it is equivalent to a no-op.
 func (s *HashSet[K]) Add(val K) {
@@ -66,18 +91,5 @@ func (s *HashSet[K]) IsNotEmpty() bool {
 
 // Formatted returns a string representation of the set.
 func (s *HashSet[K]) Formatted() string {
-	entries := s.innerMap.Entries()
-	str := "{"
-
-	for _, entry := range entries {
-		str += fmt.Sprintf("%T, ", entry.Key)
-	}
-
-	// remove last comma and space str if it exists
-	if len(str) > 1 {
-		str = str[:len(str)-2]
-	}
-
-	str += "}"
-	return str
+	return s.String()
 }
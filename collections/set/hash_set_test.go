@@ -3,6 +3,7 @@ package set
 import (
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"utils-generics/collections/types"
 )
 
 func h(i int) int {
@@ -76,3 +77,62 @@ func TestHashTableSet_RemoveNonExistent(t *testing.T) {
 	assert.False(t, ok)
 	assert.Equal(t, 2, s.Size())
 }
+
+func TestHashTableSet_CustomEquals(t *testing.T) {
+	mod := func(i int) int { return i % 10 }
+	var s Set[int] = MakeHashSetWithEquals[int](mod, func(a, b int) bool { return a%10 == b%10 })
+	s.Add(1)
+	s.Add(11)
+
+	assert.Equal(t, 1, s.Size())
+	assert.True(t, s.Contains(21))
+}
+
+func TestHashTableSet_Comparable(t *testing.T) {
+	var s Set[int] = MakeComparableHashSet[int](h)
+	s.Add(1)
+	s.Add(129)
+
+	assert.True(t, s.Contains(129))
+	assert.False(t, s.Contains(257))
+}
+
+type point struct {
+	x, y int
+}
+
+func (p point) Hash() uint64 {
+	return uint64(p.x)*31 + uint64(p.y)
+}
+
+func (p point) Equal(other point) bool {
+	return p == other
+}
+
+func TestHashTableSet_Hashable(t *testing.T) {
+	var s Set[point] = MakeHashableSet[point]()
+	s.Add(point{1, 2})
+	s.Add(point{1, 2})
+	s.Add(point{2, 1})
+
+	assert.Equal(t, 2, s.Size())
+	assert.True(t, s.Contains(point{2, 1}))
+}
+
+func TestHashTableSet_WithHasher(t *testing.T) {
+	var s Set[string] = MakeHashSetWithHasher[string](types.MakeComparableHasher[string](types.StringHash))
+	s.Add("a")
+
+	assert.True(t, s.Contains("a"))
+	assert.False(t, s.Contains("b"))
+}
+
+func TestHashTableSet_Default(t *testing.T) {
+	var s Set[int] = MakeDefaultHashSet[int]()
+	s.Add(-1)
+	s.Add(1)
+	s.Add(-1)
+
+	assert.Equal(t, 2, s.Size())
+	assert.True(t, s.Contains(-1))
+}
@@ -0,0 +1,127 @@
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// File: json.go
+// JSON encoding of the sets.
+//
+// Sets encode as JSON arrays of their elements, sorted sets in order, and multisets as arrays of
+// {"val": .., "count": ..} objects. Hashers and comparators cannot be encoded, so sets must be created
+// with their Make function before unmarshalling into them; unmarshalling replaces their contents.
+
+func errNotConstructed(name string) error {
+	return fmt.Errorf("set: cannot unmarshal JSON into a %s that was not created with a Make function", name)
+}
+
+// marshalElements encodes the elements as a JSON array, never as null
+func marshalElements[K any](elements []K) ([]byte, error) {
+	if elements == nil {
+		elements = []K{}
+	}
+	return json.Marshal(elements)
+}
+
+// unmarshalInto decodes a JSON array and replaces the elements of the set with its elements
+func unmarshalInto[K any](data []byte, s Set[K]) error {
+	var elements []K
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, val := range elements {
+		s.Add(val)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array
+func (s *HashSet[K]) MarshalJSON() ([]byte, error) {
+	return marshalElements(s.innerMap.Keys())
+}
+
+// UnmarshalJSON replaces the elements of the set with the ones of a JSON array.
+// The set must have been created with one of the Make functions, as its hasher cannot be decoded.
+func (s *HashSet[K]) UnmarshalJSON(data []byte) error {
+	if s.innerMap == nil {
+		return errNotConstructed("HashSet")
+	}
+	return unmarshalInto[K](data, s)
+}
+
+// MarshalJSON encodes the set as a JSON array, in order
+func (s *BinaryTreeSet[K]) MarshalJSON() ([]byte, error) {
+	return marshalElements(s.innerMap.Keys())
+}
+
+// UnmarshalJSON replaces the elements of the set with the ones of a JSON array.
+// The set must have been created with MakeBinaryTreeSet, as its comparator cannot be decoded.
+func (s *BinaryTreeSet[K]) UnmarshalJSON(data []byte) error {
+	if s.innerMap == nil {
+		return errNotConstructed("BinaryTreeSet")
+	}
+	return unmarshalInto[K](data, s)
+}
+
+// MarshalJSON encodes the set as a JSON array, in order
+func (s *FlatSet[K]) MarshalJSON() ([]byte, error) {
+	return marshalElements(s.innerMap.Keys())
+}
+
+// UnmarshalJSON replaces the elements of the set with the ones of a JSON array.
+// The set must have been created with MakeFlatSet, as its comparator cannot be decoded.
+func (s *FlatSet[K]) UnmarshalJSON(data []byte) error {
+	if s.innerMap == nil {
+		return errNotConstructed("FlatSet")
+	}
+	return unmarshalInto[K](data, s)
+}
+
+// MarshalJSON encodes the set as a JSON array, in order
+func (s *SkipListSet[K]) MarshalJSON() ([]byte, error) {
+	return marshalElements(s.innerMap.Keys())
+}
+
+// UnmarshalJSON replaces the elements of the set with the ones of a JSON array.
+// The set must have been created with one of the Make functions, as its comparator cannot be decoded.
+func (s *SkipListSet[K]) UnmarshalJSON(data []byte) error {
+	if s.innerMap == nil {
+		return errNotConstructed("SkipListSet")
+	}
+	return unmarshalInto[K](data, s)
+}
+
+// MarshalJSON encodes the multiset as a JSON array of {"val": .., "count": ..} objects
+func (s *multiset[K]) MarshalJSON() ([]byte, error) {
+	entries := s.EntrySet()
+	if entries == nil {
+		entries = []MultisetEntry[K]{}
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON replaces the elements of the multiset with the ones of a JSON array of {"val": .., "count": ..} objects.
+// The multiset must have been created with its Make function, as its hasher or comparator cannot be decoded.
+func (s *multiset[K]) UnmarshalJSON(data []byte) error {
+	if s.counts == nil {
+		return errNotConstructed("multiset")
+	}
+	var entries []MultisetEntry[K]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.Count < 0 {
+			return fmt.Errorf("set: cannot unmarshal a negative number of occurrences (%d) of %v", entry.Count, entry.Val)
+		}
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Add(entry.Val, entry.Count)
+	}
+	return nil
+}
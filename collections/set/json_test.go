@@ -0,0 +1,112 @@
+package set
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestHashSet_JSON(t *testing.T) {
+	s := MakeHashSet[string](types.StringHash)
+	s.Add("a")
+	s.Add("b")
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	var elements []string
+	assert.NoError(t, json.Unmarshal(data, &elements))
+	assert.ElementsMatch(t, []string{"a", "b"}, elements)
+
+	decoded := MakeHashSet[string](types.StringHash)
+	decoded.Add("stale")
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, 2, decoded.Size())
+	assert.True(t, decoded.Contains("a"))
+	assert.False(t, decoded.Contains("stale"))
+
+	assert.Error(t, json.Unmarshal(data, &HashSet[string]{}))
+}
+
+func TestBinaryTreeSet_JSON_InOrder(t *testing.T) {
+	s := MakeBinaryTreeSet[int](types.IntComparator)
+	for _, v := range []int{3, 1, 2} {
+		s.Add(v)
+	}
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2,3]`, string(data))
+
+	decoded := MakeBinaryTreeSet[int](types.IntComparator)
+	assert.NoError(t, json.Unmarshal([]byte(`[3, 3, 2, 1]`), decoded))
+	assert.Equal(t, []int{1, 2, 3}, decoded.ToSortedSlice())
+}
+
+func TestSkipListSet_JSON(t *testing.T) {
+	s := MakeSkipListSet[int](types.IntComparator)
+	for _, v := range []int{3, 1, 2} {
+		s.Add(v)
+	}
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2,3]`, string(data))
+
+	decoded := MakeSkipListSet[int](types.IntComparator)
+	assert.NoError(t, json.Unmarshal([]byte(`[3, 3, 2, 1]`), decoded))
+	assert.Equal(t, []int{1, 2, 3}, decoded.ToSortedSlice())
+}
+
+func TestFlatSet_JSON(t *testing.T) {
+	s := MakeFlatSet[string](types.StringComparator)
+	s.Add("b")
+	s.Add("a")
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `["a","b"]`, string(data))
+
+	decoded := MakeFlatSet[string](types.StringComparator)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, 2, decoded.Size())
+	assert.True(t, decoded.Contains("b"))
+
+	empty, err := json.Marshal(MakeFlatSet[string](types.StringComparator))
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(empty))
+}
+
+func TestTreeMultiset_JSON(t *testing.T) {
+	s := MakeTreeMultiset[string](types.StringComparator)
+	s.Add("b", 1)
+	s.Add("a", 3)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"val":"a","count":3},{"val":"b","count":1}]`, string(data))
+
+	decoded := MakeTreeMultiset[string](types.StringComparator)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, 4, decoded.Size())
+	assert.Equal(t, 3, decoded.Count("a"))
+}
+
+func TestHashMultiset_JSON(t *testing.T) {
+	s := MakeHashMultiset[int](types.IntHash)
+	s.Add(7, 2)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"val":7,"count":2}]`, string(data))
+
+	decoded := MakeHashMultiset[int](types.IntHash)
+	decoded.Add(1, 1)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, 2, decoded.Size())
+	assert.Equal(t, 0, decoded.Count(1))
+
+	assert.Error(t, json.Unmarshal([]byte(`[{"val":1,"count":-1}]`), decoded))
+	assert.Equal(t, 2, decoded.Count(7))
+	assert.Error(t, json.Unmarshal(data, &HashMultiset[int]{}))
+}
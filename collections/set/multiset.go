@@ -0,0 +1,179 @@
+package set
+
+import (
+	"fmt"
+	"sort"
+	"utils-generics/collections"
+	"utils-generics/collections/dict"
+)
+
+// multiset holds the logic shared by the multiset implementations, which only differ on the map keeping the counts
+type multiset[K any] struct {
+	counts dict.Map[K, int]
+	size   int
+}
+
+// Add adds n occurrences of the element to the multiset.
+// Adding zero occurrences is a no-op and adding a negative number of occurrences panics.
+func (s *multiset[K]) Add(val K, n int) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if n < 0 {
+		panic(fmt.Sprintf("set: cannot add a negative number of occurrences (%d)", n))
+	}
+	if n == 0 {
+		return
+	}
+
+	count, _ := s.counts.Get(val)
+	s.counts.Put(val, count+n)
+	s.size += n
+}
+
+// Remove removes up to n occurrences of the element from the multiset,
+// returning true if any occurrence was removed and false if otherwise
+func (s *multiset[K]) Remove(val K, n int) bool {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	count, ok := s.counts.Get(val)
+	if !ok || n <= 0 {
+		return false
+	}
+
+	if n >= count {
+		s.counts.Remove(val)
+		s.size -= count
+	} else {
+		s.counts.Put(val, count-n)
+		s.size -= n
+	}
+	return true
+}
+
+// Count returns the number of occurrences of the element, zero if it is not in the multiset
+func (s *multiset[K]) Count(val K) int {
+	count, _ := s.counts.Get(val)
+	return count
+}
+
+// SetCount sets the number of occurrences of the element, removing it if n is zero.
+// Setting a negative number of occurrences panics.
+func (s *multiset[K]) SetCount(val K, n int) {
+	if collections.Debug {
+		defer collections.MustBeValid(s)
+	}
+
+	if n < 0 {
+		panic(fmt.Sprintf("set: cannot set a negative number of occurrences (%d)", n))
+	}
+
+	count, _ := s.counts.Get(val)
+	if n == 0 {
+		s.counts.Remove(val)
+	} else {
+		s.counts.Put(val, n)
+	}
+	s.size += n - count
+}
+
+// Contains returns true if the element occurs at least once in the multiset
+func (s *multiset[K]) Contains(val K) bool {
+	return s.counts.ContainsKey(val)
+}
+
+// EntrySet returns every distinct element of the multiset along with its count
+func (s *multiset[K]) EntrySet() []MultisetEntry[K] {
+	entries := s.counts.Entries()
+	result := make([]MultisetEntry[K], 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, MultisetEntry[K]{Val: entry.Key, Count: entry.Val})
+	}
+	return result
+}
+
+// MostCommon returns the n elements with the highest counts, in descending order of count.
+// Elements with the same count keep the iteration order of the multiset.
+// If n is negative or larger than the number of distinct elements all the elements are returned.
+func (s *multiset[K]) MostCommon(n int) []MultisetEntry[K] {
+	entries := s.EntrySet()
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Count > entries[j].Count
+	})
+
+	if n >= 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// Size returns the total number of occurrences in the multiset
+func (s *multiset[K]) Size() int {
+	return s.size
+}
+
+// IsEmpty returns true if the multiset is empty, otherwise it returns false
+func (s *multiset[K]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// IsNotEmpty returns true if the multiset is not empty, otherwise it returns false
+func (s *multiset[K]) IsNotEmpty() bool {
+	return s.size != 0
+}
+
+// Clear removes all elements from the multiset
+func (s *multiset[K]) Clear() {
+	s.counts.Clear()
+	s.size = 0
+}
+
+// contents describes the multiset for collections.Format, printing each element followed by its count
+func (s *multiset[K]) contents() collections.Contents {
+	return collections.Contents{Size: s.counts.Size(), Open: "{", Close: "}", Keyed: true,
+		KeyType: collections.TypeOf[K](), ValType: collections.TypeOf[int](),
+		Each: func(visit func(key, val any) bool) {
+			for _, entry := range s.EntrySet() {
+				if !visit(entry.Val, entry.Count) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// union keeps, for each element, the highest of the counts in both multisets
+func (s *multiset[K]) union(a, b Multiset[K]) {
+	for _, entry := range a.EntrySet() {
+		s.SetCount(entry.Val, entry.Count)
+	}
+	for _, entry := range b.EntrySet() {
+		if entry.Count > s.Count(entry.Val) {
+			s.SetCount(entry.Val, entry.Count)
+		}
+	}
+}
+
+// intersection keeps, for each element, the lowest of the counts in both multisets
+func (s *multiset[K]) intersection(a, b Multiset[K]) {
+	for _, entry := range a.EntrySet() {
+		count := b.Count(entry.Val)
+		if entry.Count < count {
+			count = entry.Count
+		}
+		s.SetCount(entry.Val, count)
+	}
+}
+
+// sum adds up, for each element, the counts in both multisets
+func (s *multiset[K]) sum(a, b Multiset[K]) {
+	for _, entry := range a.EntrySet() {
+		s.Add(entry.Val, entry.Count)
+	}
+	for _, entry := range b.EntrySet() {
+		s.Add(entry.Val, entry.Count)
+	}
+}
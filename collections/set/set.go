@@ -17,3 +17,36 @@ type OrderedSet[K any] interface {
 	RemoveLast() bool
 	ToSortedSlice() []K
 }
+
+// NavigableSet is a sorted set that supports closest-match and range queries on its elements
+type NavigableSet[K any] interface {
+	OrderedSet[K]
+	Floor(val K) (K, bool)
+	Ceiling(val K) (K, bool)
+	Lower(val K) (K, bool)
+	Higher(val K) (K, bool)
+	Range(from, to K) []K
+}
+
+// MultisetEntry is an element of a multiset along with the number of times it occurs
+type MultisetEntry[K any] struct {
+	Val   K   `json:"val"`
+	Count int `json:"count"`
+}
+
+// Multiset is a collection that allows duplicate elements, keeping a count of the occurrences of each one.
+// Size returns the total number of occurrences, not the number of distinct elements.
+type Multiset[K any] interface {
+	collections.Collection
+	Add(val K, n int)
+	Remove(val K, n int) bool
+	Count(val K) int
+	SetCount(val K, n int)
+	Contains(val K) bool
+	ElementSet() Set[K]
+	EntrySet() []MultisetEntry[K]
+	MostCommon(n int) []MultisetEntry[K]
+	Union(other Multiset[K]) Multiset[K]
+	Intersection(other Multiset[K]) Multiset[K]
+	Sum(other Multiset[K]) Multiset[K]
+}
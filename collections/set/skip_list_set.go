@@ -0,0 +1,137 @@
+package set
+
+import "utils-generics/collections/dict"
+
+// SkipListSet is a sorted set implementation using a skip list map, see dict.SkipListMap.
+//
+// It stores its elements in natural order, balanced on average whatever the order they are added in,
+// and adds an element greater than all others, e.g. a timestamp, without a search.
+// It is not thread safe and should not be used for concurrent access.
+//
+// It's performance characteristics are:
+//
+// - Add: O(log n) expected, O(1) expected for an element greater than all others
+//
+// - Remove: O(log n) expected
+//
+// - Contains: O(log n) expected
+type SkipListSet[K any] struct {
+	innerMap *dict.SkipListMap[K, bool]
+}
+
+// MakeSkipListSet creates a new SkipListSet, drawing the levels of its elements from a randomly seeded generator
+func MakeSkipListSet[K any](c func(a, b K) int) *SkipListSet[K] {
+	return &SkipListSet[K]{innerMap: dict.MakeSkipListMap[K, bool](c)}
+}
+
+// MakeSkipListSetWithSeed creates a new SkipListSet, drawing the levels of its elements from a generator
+// with the given seed, e.g. to get the same layout in every run of a test
+func MakeSkipListSetWithSeed[K any](c func(a, b K) int, seed uint64) *SkipListSet[K] {
+	return &SkipListSet[K]{innerMap: dict.MakeSkipListMapWithSeed[K, bool](c, seed)}
+}
+
+// Add adds a new element to the set
+// This operation is idempotent, so if the element already exists in the set, it is equivalent to a no-op
+func (s *SkipListSet[K]) Add(val K) {
+	s.innerMap.Put(val, true)
+}
+
+// Remove removes an element from the set
+func (s *SkipListSet[K]) Remove(val K) bool {
+	return s.innerMap.Remove(val)
+}
+
+// Contains checks if the set contains an element
+func (s *SkipListSet[K]) Contains(val K) bool {
+	return s.innerMap.ContainsKey(val)
+}
+
+// Size returns the size of the set
+func (s *SkipListSet[K]) Size() int {
+	return s.innerMap.Size()
+}
+
+// Clear removes all elements from the set
+func (s *SkipListSet[K]) Clear() {
+	s.innerMap.Clear()
+}
+
+// IsEmpty checks if the set is empty
+func (s *SkipListSet[K]) IsEmpty() bool {
+	return s.innerMap.IsEmpty()
+}
+
+// IsNotEmpty checks if the set is not empty
+func (s *SkipListSet[K]) IsNotEmpty() bool {
+	return s.innerMap.IsNotEmpty()
+}
+
+// Formatted returns a string representation of the set, in order
+func (s *SkipListSet[K]) Formatted() string {
+	return s.String()
+}
+
+// ----------------
+// OrderedSet methods
+
+// First returns the first element of the set
+func (s *SkipListSet[K]) First() K {
+	return s.innerMap.First()
+}
+
+// Last returns the last element of the set
+func (s *SkipListSet[K]) Last() K {
+	return s.innerMap.Last()
+}
+
+// RemoveFirst removes the first element of the set
+func (s *SkipListSet[K]) RemoveFirst() bool {
+	return s.innerMap.RemoveFirst()
+}
+
+// RemoveLast removes the last element of the set
+func (s *SkipListSet[K]) RemoveLast() bool {
+	return s.innerMap.RemoveLast()
+}
+
+// ToSortedSlice returns an array of the elements of the set in order
+func (s *SkipListSet[K]) ToSortedSlice() []K {
+	return s.innerMap.Keys()
+}
+
+// ----------------
+// NavigableSet methods
+
+// Floor returns the greatest element less than or equal to the given element, if any
+func (s *SkipListSet[K]) Floor(val K) (K, bool) {
+	entry, ok := s.innerMap.Floor(val)
+	return entry.Key, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to the given element, if any
+func (s *SkipListSet[K]) Ceiling(val K) (K, bool) {
+	entry, ok := s.innerMap.Ceiling(val)
+	return entry.Key, ok
+}
+
+// Lower returns the greatest element strictly less than the given element, if any
+func (s *SkipListSet[K]) Lower(val K) (K, bool) {
+	entry, ok := s.innerMap.Lower(val)
+	return entry.Key, ok
+}
+
+// Higher returns the smallest element strictly greater than the given element, if any
+func (s *SkipListSet[K]) Higher(val K) (K, bool) {
+	entry, ok := s.innerMap.Higher(val)
+	return entry.Key, ok
+}
+
+// Range returns, in order, the elements in the half-open interval [from, to)
+func (s *SkipListSet[K]) Range(from, to K) []K {
+	entries := s.innerMap.Range(from, to)
+	keys := make([]K, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, entry.Key)
+	}
+	return keys
+}
@@ -0,0 +1,59 @@
+package set
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestSkipListSet_Add(t *testing.T) {
+	var s Set[int] = MakeSkipListSet[int](types.IntComparator)
+	s.Add(2)
+	s.Add(1)
+	s.Add(2)
+
+	assert.Equal(t, 2, s.Size())
+	assert.True(t, s.Contains(1))
+	assert.False(t, s.Contains(3))
+	assert.True(t, s.Remove(1))
+	assert.False(t, s.Remove(1))
+	assert.True(t, s.IsNotEmpty())
+}
+
+func TestSkipListSet_Ordered(t *testing.T) {
+	var s OrderedSet[int] = MakeSkipListSetWithSeed[int](types.IntComparator, 1)
+	for _, v := range []int{5, 1, 9, 3, 7} {
+		s.Add(v)
+	}
+
+	assert.Equal(t, 1, s.First())
+	assert.Equal(t, 9, s.Last())
+	assert.True(t, s.RemoveFirst())
+	assert.True(t, s.RemoveLast())
+	assert.Equal(t, []int{3, 5, 7}, s.ToSortedSlice())
+}
+
+func TestSkipListSet_Navigable(t *testing.T) {
+	var s NavigableSet[int] = MakeSkipListSet[int](types.IntComparator)
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		s.Add(v)
+	}
+
+	val, ok := s.Floor(45)
+	assert.True(t, ok)
+	assert.Equal(t, 40, val)
+
+	val, ok = s.Ceiling(45)
+	assert.True(t, ok)
+	assert.Equal(t, 50, val)
+
+	_, ok = s.Lower(20)
+	assert.False(t, ok)
+
+	val, ok = s.Higher(70)
+	assert.True(t, ok)
+	assert.Equal(t, 80, val)
+
+	assert.Equal(t, []int{30, 40, 50, 60}, s.Range(30, 70))
+	assert.Empty(t, s.Range(90, 100))
+}
@@ -0,0 +1,108 @@
+package set
+
+import "utils-generics/collections/dict"
+
+// TreeMultiset is a multiset implementation using a binary tree map to keep the count of each element.
+//
+// It stores its elements in natural order i.e. ints are stored in ascending order, strings are stored in alphabetical order.
+// It is not thread safe and should not be used for concurrent access
+// (see concurrent package for thread safe implementations).
+//
+// It's performance characteristics are:
+//
+// - Add: O(log n)
+//
+// - Remove: O(log n)
+//
+// - Count: O(log n)
+type TreeMultiset[K any] struct {
+	multiset[K]
+	tree       *dict.BinaryTreeMap[K, int]
+	comparator func(a, b K) int
+}
+
+// MakeTreeMultiset creates a new TreeMultiset.
+func MakeTreeMultiset[K any](c func(a, b K) int) *TreeMultiset[K] {
+	tree := dict.MakeBinaryTreeMap[K, int](c)
+	return &TreeMultiset[K]{multiset: multiset[K]{counts: tree}, tree: tree, comparator: c}
+}
+
+// ElementSet returns a BinaryTreeSet with the distinct elements of the multiset.
+// The set is a copy, so changes to it are not reflected on the multiset.
+func (s *TreeMultiset[K]) ElementSet() Set[K] {
+	elements := MakeBinaryTreeSet[K](s.comparator)
+	for _, key := range s.tree.Keys() {
+		elements.Add(key)
+	}
+	return elements
+}
+
+// Union returns a new multiset where each element occurs the maximum of the times it occurs in both multisets.
+func (s *TreeMultiset[K]) Union(other Multiset[K]) Multiset[K] {
+	result := MakeTreeMultiset[K](s.comparator)
+	result.union(s, other)
+	return result
+}
+
+// Intersection returns a new multiset where each element occurs the minimum of the times it occurs in both multisets.
+func (s *TreeMultiset[K]) Intersection(other Multiset[K]) Multiset[K] {
+	result := MakeTreeMultiset[K](s.comparator)
+	result.intersection(s, other)
+	return result
+}
+
+// Sum returns a new multiset where each element occurs the sum of the times it occurs in both multisets.
+func (s *TreeMultiset[K]) Sum(other Multiset[K]) Multiset[K] {
+	result := MakeTreeMultiset[K](s.comparator)
+	result.sum(s, other)
+	return result
+}
+
+// ----------------
+// Navigable methods
+
+// First returns the smallest element of the multiset
+func (s *TreeMultiset[K]) First() K {
+	key, _ := s.tree.First()
+	return key
+}
+
+// Last returns the greatest element of the multiset
+func (s *TreeMultiset[K]) Last() K {
+	key, _ := s.tree.Last()
+	return key
+}
+
+// Floor returns the greatest element less than or equal to the given element, if any
+func (s *TreeMultiset[K]) Floor(val K) (K, bool) {
+	entry, ok := s.tree.Floor(val)
+	return entry.Key, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to the given element, if any
+func (s *TreeMultiset[K]) Ceiling(val K) (K, bool) {
+	entry, ok := s.tree.Ceiling(val)
+	return entry.Key, ok
+}
+
+// Lower returns the greatest element strictly less than the given element, if any
+func (s *TreeMultiset[K]) Lower(val K) (K, bool) {
+	entry, ok := s.tree.Lower(val)
+	return entry.Key, ok
+}
+
+// Higher returns the smallest element strictly greater than the given element, if any
+func (s *TreeMultiset[K]) Higher(val K) (K, bool) {
+	entry, ok := s.tree.Higher(val)
+	return entry.Key, ok
+}
+
+// Range returns, in order, the elements in the half-open interval [from, to) along with their counts
+func (s *TreeMultiset[K]) Range(from, to K) []MultisetEntry[K] {
+	entries := s.tree.Range(from, to)
+	result := make([]MultisetEntry[K], 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, MultisetEntry[K]{Val: entry.Key, Count: entry.Val})
+	}
+	return result
+}
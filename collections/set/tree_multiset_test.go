@@ -0,0 +1,86 @@
+package set
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestTreeMultiset_Add(t *testing.T) {
+	var s Multiset[int] = MakeTreeMultiset[int](types.IntComparator)
+	s.Add(3, 2)
+	s.Add(1, 1)
+	s.Add(3, 1)
+
+	assert.Equal(t, 4, s.Size())
+	assert.Equal(t, 3, s.Count(3))
+	assert.Equal(t, []MultisetEntry[int]{{Val: 1, Count: 1}, {Val: 3, Count: 3}}, s.EntrySet())
+}
+
+func TestTreeMultiset_Remove(t *testing.T) {
+	var s Multiset[int] = MakeTreeMultiset[int](types.IntComparator)
+	s.Add(1, 2)
+	s.Add(2, 2)
+
+	assert.True(t, s.Remove(1, 1))
+	assert.True(t, s.Remove(2, 2))
+	assert.Equal(t, 1, s.Size())
+	assert.Equal(t, "{1: 1}", s.Formatted())
+}
+
+func TestTreeMultiset_MostCommonTiesKeepOrder(t *testing.T) {
+	var s Multiset[int] = MakeTreeMultiset[int](types.IntComparator)
+	s.Add(3, 2)
+	s.Add(2, 2)
+	s.Add(1, 1)
+
+	assert.Equal(t, []MultisetEntry[int]{{Val: 2, Count: 2}, {Val: 3, Count: 2}, {Val: 1, Count: 1}}, s.MostCommon(-1))
+}
+
+func TestTreeMultiset_ElementSetIsOrdered(t *testing.T) {
+	var s Multiset[int] = MakeTreeMultiset[int](types.IntComparator)
+	s.Add(3, 2)
+	s.Add(1, 1)
+
+	elements := s.ElementSet().(OrderedSet[int])
+	assert.Equal(t, []int{1, 3}, elements.ToSortedSlice())
+}
+
+func TestTreeMultiset_NavigableQueries(t *testing.T) {
+	s := MakeTreeMultiset[int](types.IntComparator)
+	s.Add(10, 1)
+	s.Add(20, 2)
+	s.Add(30, 3)
+
+	assert.Equal(t, 10, s.First())
+	assert.Equal(t, 30, s.Last())
+
+	val, ok := s.Floor(25)
+	assert.True(t, ok)
+	assert.Equal(t, 20, val)
+
+	val, ok = s.Ceiling(20)
+	assert.True(t, ok)
+	assert.Equal(t, 20, val)
+
+	val, ok = s.Higher(20)
+	assert.True(t, ok)
+	assert.Equal(t, 30, val)
+
+	_, ok = s.Lower(10)
+	assert.False(t, ok)
+
+	assert.Equal(t, []MultisetEntry[int]{{Val: 20, Count: 2}, {Val: 30, Count: 3}}, s.Range(15, 31))
+}
+
+func TestTreeMultiset_Operations(t *testing.T) {
+	a := MakeTreeMultiset[int](types.IntComparator)
+	a.Add(1, 2)
+	b := MakeTreeMultiset[int](types.IntComparator)
+	b.Add(1, 3)
+	b.Add(2, 1)
+
+	assert.Equal(t, []MultisetEntry[int]{{Val: 1, Count: 3}, {Val: 2, Count: 1}}, a.Union(b).EntrySet())
+	assert.Equal(t, []MultisetEntry[int]{{Val: 1, Count: 2}}, a.Intersection(b).EntrySet())
+	assert.Equal(t, []MultisetEntry[int]{{Val: 1, Count: 5}, {Val: 2, Count: 1}}, a.Sum(b).EntrySet())
+}
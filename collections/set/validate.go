@@ -0,0 +1,51 @@
+package set
+
+import (
+	"fmt"
+	"utils-generics/collections"
+)
+
+// File: validate.go
+// Checking of the internal invariants of the sets, see collections.Validator.
+
+// Validate checks the invariants of the underlying map
+func (s *HashSet[K]) Validate() error {
+	return s.innerMap.Validate()
+}
+
+// Validate checks the invariants of the underlying map
+func (s *BinaryTreeSet[K]) Validate() error {
+	return s.innerMap.Validate()
+}
+
+// Validate checks the invariants of the underlying map
+func (s *FlatSet[K]) Validate() error {
+	return s.innerMap.Validate()
+}
+
+// Validate checks the invariants of the underlying map
+func (s *SkipListSet[K]) Validate() error {
+	return s.innerMap.Validate()
+}
+
+// Validate checks that every count is positive and that they add up to the size of the multiset,
+// along with the invariants of the map holding the counts
+func (s *multiset[K]) Validate() error {
+	if v, ok := s.counts.(collections.Validator); ok {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+
+	total := 0
+	for _, entry := range s.counts.Entries() {
+		if entry.Val <= 0 {
+			return fmt.Errorf("set: multiset element %v has count %d", entry.Key, entry.Val)
+		}
+		total += entry.Val
+	}
+	if total != s.size {
+		return fmt.Errorf("set: multiset counts add up to %d but its size is %d", total, s.size)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package set
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"utils-generics/collections/types"
+)
+
+func TestSets_Validate(t *testing.T) {
+	hashSet := MakeDefaultHashSet[int]()
+	treeSet := MakeBinaryTreeSet[int](types.IntComparator)
+	flatSet := MakeFlatSet[int](types.IntComparator)
+	skipListSet := MakeSkipListSet[int](types.IntComparator)
+	for _, val := range []int{5, 3, 8, 3, 1} {
+		hashSet.Add(val)
+		treeSet.Add(val)
+		flatSet.Add(val)
+		skipListSet.Add(val)
+	}
+	flatSet.Remove(8)
+	skipListSet.Remove(8)
+
+	assert.NoError(t, hashSet.Validate())
+	assert.NoError(t, treeSet.Validate())
+	assert.NoError(t, flatSet.Validate())
+	assert.NoError(t, skipListSet.Validate())
+	assert.Equal(t, "{1, 3, 5}", flatSet.String())
+	assert.Equal(t, "{1, 3, 5}", skipListSet.String())
+}
+
+func TestMultiset_Validate(t *testing.T) {
+	s := MakeTreeMultiset[string](types.StringComparator)
+	s.Add("a", 3)
+	s.Add("b", 1)
+	s.Remove("a", 1)
+	s.SetCount("c", 2)
+	assert.NoError(t, s.Validate())
+
+	s.size++
+	assert.EqualError(t, s.Validate(), "set: multiset counts add up to 5 but its size is 6")
+
+	s.size--
+	s.tree.Put("d", 0)
+	assert.EqualError(t, s.Validate(), "set: multiset element d has count 0")
+}
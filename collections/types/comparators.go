@@ -0,0 +1,105 @@
+package types
+
+// File: comparators.go
+// Generic comparators and combinators to build comparators for composite keys, e.g.
+//
+//	byAge := By(func(p Person) int { return p.Age }, Ordered[int])
+//	byName := By(func(p Person) string { return p.Name }, Ordered[string])
+//	dict.MakeBinaryTreeMap[Person, int](Then(byAge, byName))
+
+// Orderable is the set of types supporting the < operator
+type Orderable interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Ordered compares two values with the < operator.
+// Floats are ordered totally: NaN sorts before every other value and equals any other NaN, -0 equals +0.
+func Ordered[T Orderable](a, b T) int {
+	// only NaN is not equal to itself
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN:
+		return -1
+	case bNaN:
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Reverse returns a comparator ordering values in the opposite order of c
+func Reverse[T any](c func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// Then returns a lexicographic comparator, ordering values by first and breaking ties with second
+func Then[T any](first, second func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		if r := first(a, b); r != 0 {
+			return r
+		}
+		return second(a, b)
+	}
+}
+
+// By returns a comparator ordering values by the key extracted from them, e.g. a struct field
+func By[T any, K any](extract func(T) K, c func(a, b K) int) func(a, b T) int {
+	return func(a, b T) int {
+		return c(extract(a), extract(b))
+	}
+}
+
+// NilsFirst returns a comparator for pointers ordering nil before every other pointer
+// and the rest by the values they point to
+func NilsFirst[T any](c func(a, b T) int) func(a, b *T) int {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		case b == nil:
+			return 1
+		}
+		return c(*a, *b)
+	}
+}
+
+// NilsLast returns a comparator for pointers ordering nil after every other pointer
+// and the rest by the values they point to
+func NilsLast[T any](c func(a, b T) int) func(a, b *T) int {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return 1
+		case b == nil:
+			return -1
+		}
+		return c(*a, *b)
+	}
+}
+
+// SliceComparator returns a comparator ordering slices lexicographically, comparing their elements with c.
+// A slice that is a prefix of another one sorts before it.
+func SliceComparator[T any](c func(a, b T) int) func(a, b []T) int {
+	return func(a, b []T) int {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if r := c(a[i], b[i]); r != 0 {
+				return r
+			}
+		}
+		return Ordered(len(a), len(b))
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"sort"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestOrdered(t *testing.T) {
+	assert.Equal(t, -1, Ordered(1, 2))
+	assert.Equal(t, 1, Ordered("b", "a"))
+	assert.Equal(t, 0, Ordered(uint8(7), uint8(7)))
+	assert.Equal(t, 1, Ordered(uint64(math.MaxUint64), 0))
+}
+
+func TestOrdered_FloatTotalOrder(t *testing.T) {
+	nan := math.NaN()
+
+	assert.Equal(t, 0, Ordered(nan, nan))
+	assert.Equal(t, -1, Ordered(nan, math.Inf(-1)))
+	assert.Equal(t, 1, Ordered(math.Inf(-1), nan))
+	assert.Equal(t, 0, Ordered(0.0, math.Copysign(0, -1)))
+
+	values := []float64{3, nan, math.Inf(1), -1, nan, math.Inf(-1)}
+	sort.Slice(values, func(i, j int) bool { return Float64Comparator(values[i], values[j]) < 0 })
+	assert.True(t, math.IsNaN(values[0]))
+	assert.True(t, math.IsNaN(values[1]))
+	assert.Equal(t, []float64{math.Inf(-1), -1, 3, math.Inf(1)}, values[2:])
+}
+
+func TestComplexComparator_UsesImaginaryPart(t *testing.T) {
+	assert.Equal(t, -1, Complex128Comparator(complex(1, 1), complex(1, 2)))
+	assert.Equal(t, 1, Complex128Comparator(complex(2, 0), complex(1, 5)))
+	assert.Equal(t, 0, Complex128Comparator(complex(1, 2), complex(1, 2)))
+	assert.Equal(t, 1, Complex64Comparator(complex(0, 1), complex(0, float32(math.NaN()))))
+}
+
+func TestReverse(t *testing.T) {
+	c := Reverse(IntComparator)
+
+	assert.Positive(t, c(1, 2))
+	assert.Negative(t, c(2, 1))
+	assert.Zero(t, c(1, 1))
+}
+
+func TestByThen(t *testing.T) {
+	byAge := By(func(p person) int { return p.age }, Ordered[int])
+	byName := By(func(p person) string { return p.name }, Ordered[string])
+	c := Then(byAge, byName)
+
+	people := []person{{"carol", 30}, {"bob", 25}, {"alice", 30}}
+	sort.Slice(people, func(i, j int) bool { return c(people[i], people[j]) < 0 })
+
+	assert.Equal(t, []person{{"bob", 25}, {"alice", 30}, {"carol", 30}}, people)
+	assert.Zero(t, c(person{"bob", 25}, person{"bob", 25}))
+}
+
+func TestNilsFirstAndLast(t *testing.T) {
+	one, two := 1, 2
+
+	first := NilsFirst(IntComparator)
+	assert.Negative(t, first(nil, &one))
+	assert.Positive(t, first(&one, nil))
+	assert.Zero(t, first(nil, nil))
+	assert.Negative(t, first(&one, &two))
+
+	last := NilsLast(IntComparator)
+	assert.Positive(t, last(nil, &one))
+	assert.Negative(t, last(&one, nil))
+	assert.Zero(t, last(nil, nil))
+	assert.Positive(t, last(&two, &one))
+}
+
+func TestSliceComparator(t *testing.T) {
+	c := SliceComparator(IntComparator)
+
+	assert.Zero(t, c(nil, []int{}))
+	assert.Negative(t, c([]int{1, 2}, []int{1, 3}))
+	assert.Negative(t, c([]int{1, 2}, []int{1, 2, 0}))
+	assert.Positive(t, c([]int{2}, []int{1, 9, 9}))
+	assert.Zero(t, c([]int{4, 5}, []int{4, 5}))
+}
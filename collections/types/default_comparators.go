@@ -10,28 +10,59 @@ func StringComparator(a, b string) int {
 	return 1
 }
 
+// IntComparator compares ints without subtracting them, which would overflow for large values
 func IntComparator(a, b int) int {
-	return a - b
+	return Ordered(a, b)
 }
 
+func Int8Comparator(a, b int8) int {
+	return Ordered(a, b)
+}
+
+func Int16Comparator(a, b int16) int {
+	return Ordered(a, b)
+}
+
+func Int32Comparator(a, b int32) int {
+	return Ordered(a, b)
+}
+
+func Int64Comparator(a, b int64) int {
+	return Ordered(a, b)
+}
+
+func UintComparator(a, b uint) int {
+	return Ordered(a, b)
+}
+
+func Uint8Comparator(a, b uint8) int {
+	return Ordered(a, b)
+}
+
+func Uint16Comparator(a, b uint16) int {
+	return Ordered(a, b)
+}
+
+func Uint32Comparator(a, b uint32) int {
+	return Ordered(a, b)
+}
+
+func Uint64Comparator(a, b uint64) int {
+	return Ordered(a, b)
+}
+
+func UintptrComparator(a, b uintptr) int {
+	return Ordered(a, b)
+}
+
+// Float32Comparator orders floats totally: NaN sorts before every other value, including -Inf, and -0 equals +0
 func Float32Comparator(a, b float32) int {
-	if a == b {
-		return 0
-	}
-	if a < b {
-		return -1
-	}
-	return 1
+	return Ordered(a, b)
 }
 
+// Float64Comparator orders floats totally: NaN sorts before every other value, including -Inf, and -0 equals +0
 func Float64Comparator(a, b float64) int {
-	if a == b {
-		return 0
-	}
-	if a < b {
-		return -1
-	}
-	return 1
+	return Ordered(a, b)
 }
 
 func BoolComparator(a, b bool) int {
@@ -45,29 +76,27 @@ func BoolComparator(a, b bool) int {
 }
 
 func RuneComparator(a, b rune) int {
-	return int(a) - int(b)
+	return Ordered(a, b)
 }
 
 func ByteComparator(a, b byte) int {
-	return int(a) - int(b)
+	return Ordered(a, b)
 }
 
+// Complex64Comparator orders complex numbers by their real part and then by their imaginary part,
+// each ordered like Float32Comparator
 func Complex64Comparator(a, b complex64) int {
-	if real(a) == real(b) {
-		return 0
-	}
-	if real(a) < real(b) {
-		return -1
+	if c := Ordered(real(a), real(b)); c != 0 {
+		return c
 	}
-	return 1
+	return Ordered(imag(a), imag(b))
 }
 
+// Complex128Comparator orders complex numbers by their real part and then by their imaginary part,
+// each ordered like Float64Comparator
 func Complex128Comparator(a, b complex128) int {
-	if real(a) == real(b) {
-		return 0
+	if c := Ordered(real(a), real(b)); c != 0 {
+		return c
 	}
-	if real(a) < real(b) {
-		return -1
-	}
-	return 1
+	return Ordered(imag(a), imag(b))
 }
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func sign(i int) int {
+	switch {
+	case i < 0:
+		return -1
+	case i > 0:
+		return 1
+	}
+	return 0
+}
+
+// checkComparator verifies that c is reflexive and antisymmetric on every pair of the values,
+// and transitive on the three of them
+func checkComparator[T any](t *testing.T, name string, c func(a, b T) int, values ...T) {
+	for _, a := range values {
+		if c(a, a) != 0 {
+			t.Fatalf("%s: %v is not equal to itself", name, a)
+		}
+		for _, b := range values {
+			if sign(c(a, b)) != -sign(c(b, a)) {
+				t.Fatalf("%s: not antisymmetric for %v and %v", name, a, b)
+			}
+			for _, d := range values {
+				if c(a, b) <= 0 && c(b, d) <= 0 && c(a, d) > 0 {
+					t.Fatalf("%s: not transitive for %v <= %v <= %v", name, a, b, d)
+				}
+			}
+		}
+	}
+}
+
+func FuzzComparators(f *testing.F) {
+	f.Add(int64(0), int64(1), int64(-1), 0.0, 1.5, -2.5, "", "a", "b", false, true)
+	f.Add(int64(math.MaxInt64), int64(math.MinInt64), int64(1), math.Inf(1), math.NaN(), math.Copysign(0, -1), "z", "za", "a", true, true)
+	f.Add(int64(math.MinInt64), int64(math.MaxInt64), int64(-1), math.NaN(), math.Inf(-1), 0.0, "abc", "abd", "ab", false, false)
+	f.Add(int64(math.MaxInt32), int64(math.MinInt32), int64(math.MaxUint32), math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64, "\xff", "\x00", "", true, false)
+
+	f.Fuzz(func(t *testing.T, i1, i2, i3 int64, f1, f2, f3 float64, s1, s2, s3 string, b1, b2 bool) {
+		checkComparator(t, "IntComparator", IntComparator, int(i1), int(i2), int(i3))
+		checkComparator(t, "Int8Comparator", Int8Comparator, int8(i1), int8(i2), int8(i3))
+		checkComparator(t, "Int16Comparator", Int16Comparator, int16(i1), int16(i2), int16(i3))
+		checkComparator(t, "Int32Comparator", Int32Comparator, int32(i1), int32(i2), int32(i3))
+		checkComparator(t, "Int64Comparator", Int64Comparator, i1, i2, i3)
+		checkComparator(t, "UintComparator", UintComparator, uint(i1), uint(i2), uint(i3))
+		checkComparator(t, "Uint8Comparator", Uint8Comparator, uint8(i1), uint8(i2), uint8(i3))
+		checkComparator(t, "Uint16Comparator", Uint16Comparator, uint16(i1), uint16(i2), uint16(i3))
+		checkComparator(t, "Uint32Comparator", Uint32Comparator, uint32(i1), uint32(i2), uint32(i3))
+		checkComparator(t, "Uint64Comparator", Uint64Comparator, uint64(i1), uint64(i2), uint64(i3))
+		checkComparator(t, "UintptrComparator", UintptrComparator, uintptr(i1), uintptr(i2), uintptr(i3))
+		checkComparator(t, "RuneComparator", RuneComparator, rune(i1), rune(i2), rune(i3))
+		checkComparator(t, "ByteComparator", ByteComparator, byte(i1), byte(i2), byte(i3))
+		checkComparator(t, "StringComparator", StringComparator, s1, s2, s3)
+		checkComparator(t, "BoolComparator", BoolComparator, b1, b2)
+		checkComparator(t, "Float32Comparator", Float32Comparator, float32(f1), float32(f2), float32(f3))
+		checkComparator(t, "Float64Comparator", Float64Comparator, f1, f2, f3)
+		checkComparator(t, "Complex64Comparator", Complex64Comparator,
+			complex(float32(f1), float32(f2)), complex(float32(f2), float32(f3)), complex(float32(f1), float32(f3)))
+		checkComparator(t, "Complex128Comparator", Complex128Comparator, complex(f1, f2), complex(f2, f3), complex(f1, f3))
+		checkComparator(t, "SliceComparator", SliceComparator(Int64Comparator), []int64{i1, i2}, []int64{i2}, []int64{i1, i3, i2})
+	})
+}
+
+func TestIntComparators_DoNotOverflow(t *testing.T) {
+	assert.Negative(t, IntComparator(math.MinInt, 1))
+	assert.Positive(t, IntComparator(math.MaxInt, -1))
+	assert.Negative(t, Int64Comparator(math.MinInt64, math.MaxInt64))
+	assert.Positive(t, Uint64Comparator(math.MaxUint64, 0))
+	assert.Positive(t, UintComparator(math.MaxUint, 1))
+	assert.Negative(t, Int8Comparator(math.MinInt8, math.MaxInt8))
+	assert.Positive(t, UintptrComparator(^uintptr(0), 0))
+}
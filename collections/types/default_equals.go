@@ -0,0 +1,17 @@
+package types
+
+import "reflect"
+
+// File: default_equals.go
+// Common equality functions for go types
+
+// DeepEquals compares any two values with reflect.DeepEqual.
+// It works for every type but is slow, prefer Equals or a dedicated function on hot paths.
+func DeepEquals[K any](a, b K) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// Equals compares two values of a comparable type with ==
+func Equals[K comparable](a, b K) bool {
+	return a == b
+}
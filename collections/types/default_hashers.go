@@ -1,29 +1,70 @@
 package types
 
-import "hash/fnv"
+import (
+	"hash/fnv"
+	"math"
+)
 
 // File: default_hashers.go
 // Common hash function for go types
+//
+// Every hasher mixes its input so that close keys land in unrelated buckets, and never returns a negative value,
+// so that hash % size is always a valid bucket index. They are deterministic, so maps whose keys come from
+// untrusted input should hash them with a seeded Hasher instead, see DefaultHasher.
 
+// nonNegative turns a 64-bit hash into a non-negative int, on both 32 and 64-bit platforms
+func nonNegative(h uint64) int {
+	return int(h & math.MaxInt)
+}
+
+// StringHash hashes a string with FNV-1a, for the collections that take a func(K) int hasher.
+// The result is mixed since the low bits of FNV-1a alone are poorly spread for keys sharing a prefix.
+// Hasher based collections should prefer the full 64 bits of StringHash64.
 func StringHash(s string) int {
 	h := fnv.New32a()
 	_, err := h.Write([]byte(s))
 	if err != nil {
 		return 0
 	}
-	return int(h.Sum32()) // TODO make the hasher interface be a uint32
+	return nonNegative(Mix64(uint64(h.Sum32())))
+}
+
+// StringHash64 hashes a string with 64-bit FNV-1a
+func StringHash64(s string) uint64 {
+	h := fnv.New64a()
+	_, err := h.Write([]byte(s))
+	if err != nil {
+		return 0
+	}
+	return h.Sum64()
 }
 
+// IntHash hashes an int by mixing its bits, so that sequential or strided keys spread over all buckets
 func IntHash(i int) int {
-	return i
+	return nonNegative(Mix64(uint64(i)))
 }
 
+// Float32Hash hashes a float32 by its bit pattern, see Float64Hash for the handling of zeros and NaNs
 func Float32Hash(f float32) int {
-	return int(f)
+	return nonNegative(float64Bits(float64(f)))
 }
 
+// Float64Hash hashes a float64 by its bit pattern.
+//
+// -0 and +0 are equal, so they hash alike. Every NaN hashes alike as well, although a NaN key can never be found
+// by a == comparison since NaN != NaN.
 func Float64Hash(f float64) int {
-	return int(f)
+	return nonNegative(float64Bits(f))
+}
+
+func float64Bits(f float64) uint64 {
+	if f == 0 {
+		return Mix64(0)
+	}
+	if f != f {
+		return Mix64(0x7ff8000000000001)
+	}
+	return Mix64(math.Float64bits(f))
 }
 
 func BoolHash(b bool) int {
@@ -33,18 +74,28 @@ func BoolHash(b bool) int {
 	return 0
 }
 
+// RuneHash hashes a rune by mixing its bits
 func RuneHash(r rune) int {
-	return int(r)
+	return nonNegative(Mix64(uint64(r)))
 }
 
+// ByteHash hashes a byte by mixing its bits
 func ByteHash(b byte) int {
-	return int(b)
+	return nonNegative(Mix64(uint64(b)))
 }
 
+// Complex64Hash hashes a complex64 combining the hashes of its real and imaginary parts
 func Complex64Hash(c complex64) int {
-	return int(real(c)) + int(imag(c))
+	return nonNegative(complexBits(complex128(c)))
 }
 
+// Complex128Hash hashes a complex128 combining the hashes of its real and imaginary parts
 func Complex128Hash(c complex128) int {
-	return int(real(c)) + int(imag(c))
+	return nonNegative(complexBits(c))
+}
+
+func complexBits(c complex128) uint64 {
+	// the rotation keeps a+bi and b+ai apart
+	im := float64Bits(imag(c))
+	return Mix64(float64Bits(real(c)) ^ (im<<31 | im>>33))
 }
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strconv"
+	"testing"
+)
+
+// chiSquare measures how far the bucket counts of the hashes are from a uniform distribution
+func chiSquare(hashes []uint64, buckets int) float64 {
+	counts := make([]int, buckets)
+	for _, h := range hashes {
+		counts[h%uint64(buckets)]++
+	}
+
+	expected := float64(len(hashes)) / float64(buckets)
+	var x2 float64
+	for _, c := range counts {
+		d := float64(c) - expected
+		x2 += d * d / expected
+	}
+	return x2
+}
+
+// assertUniform checks the chi-square statistic against its distribution for buckets-1 degrees of freedom,
+// allowing six standard deviations over the mean
+func assertUniform(t *testing.T, name string, hashes []uint64) {
+	for _, buckets := range []int{128, 1024} {
+		df := float64(buckets - 1)
+		limit := df + 6*math.Sqrt(2*df)
+		x2 := chiSquare(hashes, buckets)
+		assert.Less(t, x2, limit, "%s is not uniform over %d buckets (chi-square %.1f)", name, buckets, x2)
+	}
+}
+
+func intHashes[K any](n int, key func(i int) K, hash func(K) int) []uint64 {
+	hashes := make([]uint64, 0, n)
+	for i := 0; i < n; i++ {
+		hashes = append(hashes, uint64(hash(key(i))))
+	}
+	return hashes
+}
+
+func TestDefaultHashers_Distribution(t *testing.T) {
+	const n = 1 << 16
+
+	assertUniform(t, "IntHash sequential", intHashes(n, func(i int) int { return i }, IntHash))
+	assertUniform(t, "IntHash strided", intHashes(n, func(i int) int { return i * 1024 }, IntHash))
+	assertUniform(t, "IntHash negative", intHashes(n, func(i int) int { return -i }, IntHash))
+	assertUniform(t, "RuneHash", intHashes(n, func(i int) rune { return rune(i) }, RuneHash))
+	assertUniform(t, "StringHash", intHashes(n, func(i int) string { return "key-" + strconv.Itoa(i) }, StringHash))
+	assertUniform(t, "Float32Hash", intHashes(n, func(i int) float32 { return float32(i) / 10 }, Float32Hash))
+	assertUniform(t, "Float64Hash", intHashes(n, func(i int) float64 { return float64(i) / 10 }, Float64Hash))
+	assertUniform(t, "Float64Hash integers", intHashes(n, func(i int) float64 { return float64(i) }, Float64Hash))
+	assertUniform(t, "Complex64Hash", intHashes(n, func(i int) complex64 { return complex(float32(i%256), float32(i/256)) }, Complex64Hash))
+	assertUniform(t, "Complex128Hash", intHashes(n, func(i int) complex128 { return complex(float64(i/256), float64(i%256)) }, Complex128Hash))
+}
+
+func TestDefaultHashers_ByteDistribution(t *testing.T) {
+	// only 256 keys, so only the small table is meaningful
+	hashes := intHashes(256, func(i int) byte { return byte(i) }, ByteHash)
+	x2 := chiSquare(hashes, 16)
+	assert.Less(t, x2, 15+6*math.Sqrt(30))
+}
+
+func TestSeededHashers_Distribution(t *testing.T) {
+	const n = 1 << 16
+
+	for _, seed := range []Seed{MakeSeed(), MakeDeterministicSeed(42)} {
+		ints := IntegerHasher[int](seed)
+		strs := StringHasher(seed)
+		floats := FloatHasher[float64](seed)
+
+		hashes := make([][]uint64, 3)
+		for i := 0; i < n; i++ {
+			hashes[0] = append(hashes[0], ints.Hash(i))
+			hashes[1] = append(hashes[1], strs.Hash("key-"+strconv.Itoa(i)))
+			hashes[2] = append(hashes[2], floats.Hash(float64(i)/10))
+		}
+
+		assertUniform(t, "IntegerHasher", hashes[0])
+		assertUniform(t, "StringHasher", hashes[1])
+		assertUniform(t, "FloatHasher", hashes[2])
+	}
+}
+
+func TestDefaultHashers_NonNegative(t *testing.T) {
+	for _, i := range []int{0, 1, -1, math.MinInt, math.MaxInt, 1 << 40} {
+		assert.GreaterOrEqual(t, IntHash(i), 0)
+	}
+	for _, f := range []float64{-1.5, math.Inf(-1), math.Inf(1), math.NaN(), -math.MaxFloat64} {
+		assert.GreaterOrEqual(t, Float64Hash(f), 0)
+	}
+	assert.GreaterOrEqual(t, Complex128Hash(complex(-1, -1)), 0)
+	assert.GreaterOrEqual(t, RuneHash(-1), 0)
+}
+
+func TestFloatHash_DistinguishesFractions(t *testing.T) {
+	assert.NotEqual(t, Float64Hash(1.1), Float64Hash(1.9))
+	assert.NotEqual(t, Float32Hash(1.1), Float32Hash(1.9))
+}
+
+func TestFloatHash_Zeros(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+
+	assert.Equal(t, Float64Hash(0), Float64Hash(negativeZero))
+	assert.Equal(t, Float32Hash(0), Float32Hash(float32(negativeZero)))
+
+	h := FloatHasher[float64](MakeSeed())
+	assert.Equal(t, h.Hash(0), h.Hash(negativeZero))
+}
+
+func TestFloatHash_NaN(t *testing.T) {
+	otherNaN := math.Float64frombits(0x7ff0000000000042)
+
+	assert.Equal(t, Float64Hash(math.NaN()), Float64Hash(otherNaN))
+	assert.Equal(t, Float32Hash(float32(math.NaN())), Float64Hash(math.NaN()))
+}
+
+func TestComplexHash_UsesBothParts(t *testing.T) {
+	assert.NotEqual(t, Complex128Hash(complex(1, 2)), Complex128Hash(complex(2, 1)))
+	assert.NotEqual(t, Complex128Hash(complex(1, 2)), Complex128Hash(complex(1, 3)))
+	assert.NotEqual(t, Complex64Hash(complex(0.5, 0)), Complex64Hash(complex(0, 0.5)))
+}
@@ -0,0 +1,59 @@
+package types
+
+// File: hasher.go
+// Hashing abstractions for hash based collections
+
+// Hasher hashes keys and compares them for equality, for collections keyed by types that are not hashable out of the box.
+// Equal keys must have equal hashes.
+type Hasher[K any] interface {
+	Hash(key K) uint64
+	Equal(a, b K) bool
+}
+
+// Hashable is implemented by key types that know how to hash and compare themselves, e.g.
+//
+//	func (p Point) Hash() uint64 { return uint64(p.X)*31 + uint64(p.Y) }
+//	func (p Point) Equal(other Point) bool { return p == other }
+type Hashable[K any] interface {
+	Hash() uint64
+	Equal(other K) bool
+}
+
+type funcHasher[K any] struct {
+	hash  func(K) uint64
+	equal func(a, b K) bool
+}
+
+func (h funcHasher[K]) Hash(key K) uint64 {
+	return h.hash(key)
+}
+
+func (h funcHasher[K]) Equal(a, b K) bool {
+	return h.equal(a, b)
+}
+
+// MakeHasher builds a Hasher out of a hash function and an equality function
+func MakeHasher[K any](hash func(K) uint64, equal func(a, b K) bool) Hasher[K] {
+	return funcHasher[K]{hash: hash, equal: equal}
+}
+
+// MakeComparableHasher builds a Hasher out of one of the int hash functions of this package, e.g. StringHash,
+// comparing keys with ==
+func MakeComparableHasher[K comparable](hash func(K) int) Hasher[K] {
+	return MakeHasher[K](func(key K) uint64 { return uint64(hash(key)) }, Equals[K])
+}
+
+type hashableHasher[K Hashable[K]] struct{}
+
+func (hashableHasher[K]) Hash(key K) uint64 {
+	return key.Hash()
+}
+
+func (hashableHasher[K]) Equal(a, b K) bool {
+	return a.Equal(b)
+}
+
+// HashableHasher returns the Hasher of a key type implementing Hashable, delegating to the keys themselves
+func HashableHasher[K Hashable[K]]() Hasher[K] {
+	return hashableHasher[K]{}
+}
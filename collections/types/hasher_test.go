@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type point struct {
+	x, y int
+}
+
+func (p point) Hash() uint64 {
+	return uint64(p.x)*31 + uint64(p.y)
+}
+
+func (p point) Equal(other point) bool {
+	return p == other
+}
+
+func TestMakeHasher(t *testing.T) {
+	h := MakeHasher[string](func(s string) uint64 { return StringHash64(strings.ToLower(s)) }, strings.EqualFold)
+
+	assert.Equal(t, h.Hash("Go"), h.Hash("GO"))
+	assert.True(t, h.Equal("Go", "gO"))
+}
+
+func TestMakeComparableHasher(t *testing.T) {
+	h := MakeComparableHasher[string](StringHash)
+
+	assert.Equal(t, uint64(StringHash("go")), h.Hash("go"))
+	assert.True(t, h.Equal("go", "go"))
+	assert.False(t, h.Equal("go", "Go"))
+}
+
+func TestHashableHasher(t *testing.T) {
+	h := HashableHasher[point]()
+
+	assert.Equal(t, uint64(31*1+2), h.Hash(point{1, 2}))
+	assert.True(t, h.Equal(point{1, 2}, point{1, 2}))
+	assert.False(t, h.Equal(point{1, 2}, point{2, 1}))
+}
+
+func TestStringHash64(t *testing.T) {
+	assert.Equal(t, uint64(0xcbf29ce484222325), StringHash64(""))
+	assert.NotEqual(t, StringHash64("a"), StringHash64("b"))
+}
@@ -0,0 +1,189 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"reflect"
+)
+
+// File: seeded_hashers.go
+// Seeded hashers, to prevent anyone controlling the keys of a map from crafting collisions.
+// Every map should get its own random seed; deterministic seeds exist for reproducible tests only.
+
+// Integer is the set of all the integer types
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Seed parametrizes the seeded hashers
+type Seed struct {
+	random        maphash.Seed
+	fixed         uint64
+	deterministic bool
+}
+
+// MakeSeed returns a new random seed, hashing with hash/maphash
+func MakeSeed() Seed {
+	return Seed{random: maphash.MakeSeed()}
+}
+
+// MakeDeterministicSeed returns a fixed seed that yields the same hashes in every run of the program,
+// which is useful for reproducible tests but offers no protection against crafted collisions
+func MakeDeterministicSeed(seed uint64) Seed {
+	return Seed{fixed: seed, deterministic: true}
+}
+
+func (s Seed) hashString(str string) uint64 {
+	if !s.deterministic {
+		return maphash.String(s.random, str)
+	}
+
+	// FNV-1a with the seed folded into the offset basis, finalized so that the seed affects every bit
+	h := uint64(14695981039346656037) ^ s.fixed
+	for i := 0; i < len(str); i++ {
+		h ^= uint64(str[i])
+		h *= 1099511628211
+	}
+	return Mix64(h ^ uint64(len(str)))
+}
+
+func (s Seed) hashBytes(b []byte) uint64 {
+	if !s.deterministic {
+		return maphash.Bytes(s.random, b)
+	}
+	return s.hashString(string(b))
+}
+
+func (s Seed) hashUint64(v uint64) uint64 {
+	if !s.deterministic {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], v)
+		return maphash.Bytes(s.random, buf[:])
+	}
+	return Mix64(v ^ Mix64(s.fixed))
+}
+
+// Mix64 is the 64-bit finalizer of MurmurHash3, which spreads every input bit over the whole output, e.g. to
+// spread the keys of a hash whose low bits are poorly distributed, such as the identity, over a table
+func Mix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+// StringHasher returns a Hasher for strings using the given seed
+func StringHasher(seed Seed) Hasher[string] {
+	return MakeHasher[string](seed.hashString, Equals[string])
+}
+
+// BytesHasher returns a Hasher for byte slices using the given seed
+func BytesHasher(seed Seed) Hasher[[]byte] {
+	return MakeHasher[[]byte](seed.hashBytes, bytes.Equal)
+}
+
+// IntegerHasher returns a Hasher for any integer type using the given seed
+func IntegerHasher[K Integer](seed Seed) Hasher[K] {
+	return MakeHasher[K](func(key K) uint64 { return seed.hashUint64(uint64(key)) }, Equals[K])
+}
+
+// FloatHasher returns a Hasher for floats using the given seed, hashing -0 and +0 alike as well as every NaN
+func FloatHasher[K ~float32 | ~float64](seed Seed) Hasher[K] {
+	return MakeHasher[K](func(key K) uint64 { return seed.hashUint64(float64Bits(float64(key))) }, Equals[K])
+}
+
+// ComplexHasher returns a Hasher for complex numbers using the given seed
+func ComplexHasher[K ~complex64 | ~complex128](seed Seed) Hasher[K] {
+	return MakeHasher[K](func(key K) uint64 { return seed.hashUint64(complexBits(complex128(key))) }, Equals[K])
+}
+
+// BoolHasher returns a Hasher for booleans using the given seed
+func BoolHasher(seed Seed) Hasher[bool] {
+	return MakeHasher[bool](func(key bool) uint64 { return seed.hashUint64(uint64(BoolHash(key))) }, Equals[bool])
+}
+
+// DefaultHasher returns a Hasher for strings and numeric or boolean types, seeded with a new random seed.
+// It panics for any other key type, which should provide its own Hasher or implement Hashable.
+func DefaultHasher[K comparable]() Hasher[K] {
+	return DefaultHasherWithSeed[K](MakeSeed())
+}
+
+// DefaultHasherWithSeed is like DefaultHasher but uses the given seed, e.g. a deterministic one in tests
+func DefaultHasherWithSeed[K comparable](seed Seed) Hasher[K] {
+	var zero K
+	var h any
+	switch any(zero).(type) {
+	case string:
+		h = StringHasher(seed)
+	case int:
+		h = IntegerHasher[int](seed)
+	case int8:
+		h = IntegerHasher[int8](seed)
+	case int16:
+		h = IntegerHasher[int16](seed)
+	case int32:
+		h = IntegerHasher[int32](seed)
+	case int64:
+		h = IntegerHasher[int64](seed)
+	case uint:
+		h = IntegerHasher[uint](seed)
+	case uint8:
+		h = IntegerHasher[uint8](seed)
+	case uint16:
+		h = IntegerHasher[uint16](seed)
+	case uint32:
+		h = IntegerHasher[uint32](seed)
+	case uint64:
+		h = IntegerHasher[uint64](seed)
+	case uintptr:
+		h = IntegerHasher[uintptr](seed)
+	case float32:
+		h = FloatHasher[float32](seed)
+	case float64:
+		h = FloatHasher[float64](seed)
+	case complex64:
+		h = ComplexHasher[complex64](seed)
+	case complex128:
+		h = ComplexHasher[complex128](seed)
+	case bool:
+		h = BoolHasher(seed)
+	default:
+		return namedHasher[K](seed)
+	}
+	return h.(Hasher[K])
+}
+
+// namedHasher returns a Hasher for a named type such as type ID string, hashing the keys by the value of the
+// builtin type underlying them
+func namedHasher[K comparable](seed Seed) Hasher[K] {
+	var zero K
+	t := reflect.TypeOf(zero)
+	if t == nil {
+		panic("types: no default hasher for interface keys, provide a Hasher or implement Hashable")
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return reflectHasher[K](StringHasher(seed), reflect.Value.String)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflectHasher[K](IntegerHasher[int64](seed), reflect.Value.Int)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflectHasher[K](IntegerHasher[uint64](seed), reflect.Value.Uint)
+	case reflect.Float32, reflect.Float64:
+		return reflectHasher[K](FloatHasher[float64](seed), reflect.Value.Float)
+	case reflect.Complex64, reflect.Complex128:
+		return reflectHasher[K](ComplexHasher[complex128](seed), reflect.Value.Complex)
+	case reflect.Bool:
+		return reflectHasher[K](BoolHasher(seed), reflect.Value.Bool)
+	default:
+		panic(fmt.Sprintf("types: no default hasher for %T, provide a Hasher or implement Hashable", zero))
+	}
+}
+
+// reflectHasher hashes the keys with the hasher of their underlying type, reading them through reflection
+func reflectHasher[K comparable, U any](h Hasher[U], value func(reflect.Value) U) Hasher[K] {
+	return MakeHasher[K](func(key K) uint64 { return h.Hash(value(reflect.ValueOf(key))) }, Equals[K])
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStringHasher_SeedsDiffer(t *testing.T) {
+	a := StringHasher(MakeSeed())
+	b := StringHasher(MakeSeed())
+
+	assert.Equal(t, a.Hash("key"), a.Hash("key"))
+	assert.NotEqual(t, a.Hash("key"), b.Hash("key"))
+}
+
+func TestStringHasher_Deterministic(t *testing.T) {
+	a := StringHasher(MakeDeterministicSeed(42))
+	b := StringHasher(MakeDeterministicSeed(42))
+	c := StringHasher(MakeDeterministicSeed(43))
+
+	assert.Equal(t, a.Hash("key"), b.Hash("key"))
+	assert.NotEqual(t, a.Hash("key"), c.Hash("key"))
+	assert.NotEqual(t, a.Hash("key"), a.Hash("kez"))
+}
+
+func TestMix64(t *testing.T) {
+	// consecutive inputs, as the identity hashes consecutive integers, land in different low bits
+	lowBits := map[uint64]bool{}
+	for i := uint64(0); i < 64; i++ {
+		lowBits[Mix64(i)&63] = true
+	}
+	assert.Greater(t, len(lowBits), 32)
+	assert.Equal(t, uint64(0), Mix64(0))
+}
+
+func TestBytesHasher(t *testing.T) {
+	for _, seed := range []Seed{MakeSeed(), MakeDeterministicSeed(1)} {
+		h := BytesHasher(seed)
+
+		assert.Equal(t, h.Hash([]byte("abc")), h.Hash([]byte("abc")))
+		assert.NotEqual(t, h.Hash([]byte("abc")), h.Hash([]byte("abd")))
+		assert.True(t, h.Equal([]byte("abc"), []byte("abc")))
+	}
+}
+
+func TestIntegerHasher(t *testing.T) {
+	random := IntegerHasher[int](MakeSeed())
+	fixed := IntegerHasher[int](MakeDeterministicSeed(7))
+
+	assert.Equal(t, random.Hash(-5), random.Hash(-5))
+	assert.NotEqual(t, random.Hash(1), random.Hash(2))
+	assert.Equal(t, fixed.Hash(12345), IntegerHasher[int](MakeDeterministicSeed(7)).Hash(12345))
+	assert.NotEqual(t, fixed.Hash(12345), IntegerHasher[int](MakeDeterministicSeed(8)).Hash(12345))
+}
+
+func TestDefaultHasher(t *testing.T) {
+	assert.NotPanics(t, func() { DefaultHasher[string]() })
+	assert.NotPanics(t, func() { DefaultHasher[uint16]() })
+	assert.Panics(t, func() { DefaultHasher[struct{ x int }]() })
+
+	seed := MakeDeterministicSeed(3)
+	assert.Equal(t, StringHasher(seed).Hash("x"), DefaultHasherWithSeed[string](seed).Hash("x"))
+}
+
+func TestDefaultHasher_NamedTypes(t *testing.T) {
+	type id string
+	type port uint16
+	type ratio float64
+	type level int8
+	seed := MakeDeterministicSeed(3)
+
+	ids := DefaultHasherWithSeed[id](seed)
+	assert.Equal(t, StringHasher(seed).Hash("x"), ids.Hash("x"))
+	assert.True(t, ids.Equal("x", "x"))
+	assert.False(t, ids.Equal("x", "y"))
+	assert.Equal(t, IntegerHasher[uint16](seed).Hash(8080), DefaultHasherWithSeed[port](seed).Hash(8080))
+	assert.Equal(t, FloatHasher[float64](seed).Hash(0.5), DefaultHasherWithSeed[ratio](seed).Hash(0.5))
+	assert.Equal(t, IntegerHasher[int8](seed).Hash(-3), DefaultHasherWithSeed[level](seed).Hash(-3))
+	assert.Panics(t, func() { DefaultHasher[any]() })
+	assert.Panics(t, func() { DefaultHasher[struct{ a int }]() })
+}
@@ -0,0 +1,20 @@
+package collections
+
+// File: validate.go
+// Checking of the internal invariants of the collections, in tests and in debug builds.
+
+// Validator is implemented by the collections that can check their internal invariants,
+// e.g. the ordering of the keys of a binary search tree
+type Validator interface {
+	// Validate returns an error describing the first broken invariant found, or nil if the collection is sound
+	Validate() error
+}
+
+// MustBeValid panics if the collection breaks any of its invariants.
+//
+// The collections call it after every mutation when built with the collections_debug tag, see Debug.
+func MustBeValid(v Validator) {
+	if err := v.Validate(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,20 @@
+package collections
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type validatorFunc func() error
+
+func (f validatorFunc) Validate() error {
+	return f()
+}
+
+func TestMustBeValid(t *testing.T) {
+	assert.NotPanics(t, func() { MustBeValid(validatorFunc(func() error { return nil })) })
+
+	err := errors.New("broken")
+	assert.PanicsWithError(t, "broken", func() { MustBeValid(validatorFunc(func() error { return err })) })
+}